@@ -1,8 +1,6 @@
 package accounts
 
 import (
-	"log"
-
 	"github.com/jinzhu/gorm"
 	"github.com/mislavio/contracter/helpers"
 	"golang.org/x/crypto/bcrypt"
@@ -20,12 +18,14 @@ type Account struct {
 }
 
 // BeforeCreate gorm hook
-func (a *Account) BeforeCreate(scope *gorm.Scope) {
-	a.BaseModel.BeforeCreate(scope)
+func (a *Account) BeforeCreate(scope *gorm.Scope) error {
+	if err := a.BaseModel.BeforeCreate(scope); err != nil {
+		return err
+	}
 	// generate hash
 	hash, err := bcrypt.GenerateFromPassword([]byte(a.Password), bcrypt.MinCost)
 	if err != nil {
-		log.Panic(err)
+		return err
 	}
 	// Add verification token
 	a.Token = helpers.RandomString(25)
@@ -33,6 +33,7 @@ func (a *Account) BeforeCreate(scope *gorm.Scope) {
 	a.Active = false
 	// Store hased password.
 	a.Password = string(hash)
+	return nil
 }
 
 // IsActive indicates if the account email has been verified.
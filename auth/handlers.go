@@ -2,7 +2,6 @@ package auth
 
 import (
 	"errors"
-	"fmt"
 	"log"
 	"net/http"
 	"regexp"
@@ -123,7 +122,8 @@ func SignUp(db *gorm.DB) http.HandlerFunc {
 		}
 
 		if err := db.Create(a).Error; err != nil {
-			log.Panic(err)
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
 		}
 
 		log.Printf("Created: account %v (%v)", a.Email, a.ID)
@@ -156,6 +156,7 @@ func SignIn(db *gorm.DB, j *ContracterJWT) http.HandlerFunc {
 
 		if !a.IsActive() {
 			render.Render(w, r, helpers.ErrUnauthorized(errors.New("email not verified")))
+			return
 		}
 
 		if err := a.ComparePassword(data.Password); err != nil {
@@ -165,7 +166,8 @@ func SignIn(db *gorm.DB, j *ContracterJWT) http.HandlerFunc {
 
 		_, tokenString, err := j.NewJWTFromAccount(a)
 		if err != nil {
-			log.Panic(err)
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
 		}
 
 		render.Render(w, r, NewSignInResponse(a, tokenString))
@@ -197,7 +199,8 @@ func VerifyEmail(db *gorm.DB) http.HandlerFunc {
 			"token":  gorm.Expr("NULL"),
 		},
 		).Error; err != nil {
-			log.Panic(err)
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
 		}
 
 		render.Render(w, r, &VerifyResponse{})
@@ -208,7 +211,7 @@ func VerifyEmail(db *gorm.DB) http.HandlerFunc {
 func WhoAmI() http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		a, _ := accountFromContext(ctx)
+		a, _ := AccountFromContext(ctx)
 		token, _, _ := tokenFromContext(ctx)
 
 		render.Render(w, r, &WhoAmIResponse{
@@ -233,7 +236,7 @@ func Verifier(j *ContracterJWT) func(http.Handler) http.Handler {
 
 				token, err := jwt.ParseWithClaims(tokenString, claims, j.Keyfunc)
 				if err != nil {
-					http.Error(w, http.StatusText(400), 400)
+					render.Render(w, r, helpers.ErrBadRequest(err))
 					return
 				}
 				ctx = context.WithValue(ctx, TokenCtxKey, token)
@@ -254,19 +257,19 @@ func AccountAuthenticator(db *gorm.DB) func(http.Handler) http.Handler {
 
 			if err != nil {
 				log.Print(err)
-				http.Error(w, http.StatusText(401), 401)
+				render.Render(w, r, helpers.ErrUnauthorized(err))
 				return
 			}
 
 			if t == nil || !t.Valid {
-				http.Error(w, http.StatusText(401), 401)
+				render.Render(w, r, helpers.ErrUnauthorized(errors.New("missing or invalid token")))
 				return
 			}
 
 			a, err := claims.GetAccountFromClaims(db)
 			if err != nil {
-				fmt.Print(err)
-				http.Error(w, "we couldn't find your account", 401)
+				log.Print(err)
+				render.Render(w, r, helpers.ErrUnauthorized(errors.New("we couldn't find your account")))
 				return
 			}
 
@@ -79,7 +79,8 @@ func newContextWithAccount(ctx context.Context, a *accounts.Account) context.Con
 	return ctx
 }
 
-func accountFromContext(ctx context.Context) (*accounts.Account, error) {
+// AccountFromContext returns the authenticated accounts.Account stored in the context.
+func AccountFromContext(ctx context.Context) (*accounts.Account, error) {
 	a, ok := ctx.Value(AccountCtxKey).(*accounts.Account)
 	if !ok {
 		return &accounts.Account{}, errors.New("auth: account not found in context")
@@ -0,0 +1,250 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ABI diff change kinds
+const (
+	ABIAdded   = "added"
+	ABIRemoved = "removed"
+	ABIChanged = "changed"
+)
+
+// ABIChange is a function, event or error which differs between two ABIs.
+type ABIChange struct {
+	Type         string `json:"type"`
+	Change       string `json:"change"`
+	Name         string `json:"name"`
+	Signature    string `json:"signature"`
+	OldSignature string `json:"oldSignature,omitempty"`
+	Breaking     bool   `json:"breaking"`
+	Message      string `json:"message"`
+}
+
+// ABIDiff lists the changes between two ABIs. Breaking is set when an
+// existing integrator could stop working, e.g. because a selector changed
+// or an event was removed.
+type ABIDiff struct {
+	Breaking bool         `json:"breaking"`
+	Changes  []*ABIChange `json:"changes"`
+}
+
+// String returns the breaking changes of the diff.
+func (d *ABIDiff) String() string {
+	var lines []string
+	for _, c := range d.Changes {
+		if c.Breaking {
+			lines = append(lines, c.Message)
+		}
+	}
+	if len(lines) == 0 {
+		return "no breaking changes"
+	}
+	return strings.Join(lines, "; ")
+}
+
+// abiEntry is a function, event or error of an ABI keyed by signature.
+type abiEntry struct {
+	typ  string
+	name string
+	sig  string
+	id   string
+	// Functions only
+	outputs  string
+	constant bool
+	payable  bool
+	// Events only
+	indexed   string
+	anonymous bool
+}
+
+// DiffABI compares the ABI of a contract version with the ABI of the
+// version it replaces.
+func DiffABI(oldJSON string, newJSON string) (*ABIDiff, error) {
+	prev, err := abiEntries(oldJSON)
+	if err != nil {
+		return nil, fmt.Errorf("old abi: %v", err)
+	}
+	next, err := abiEntries(newJSON)
+	if err != nil {
+		return nil, fmt.Errorf("new abi: %v", err)
+	}
+
+	d := &ABIDiff{Changes: []*ABIChange{}}
+	for _, typ := range []string{"function", "event", "error"} {
+		d.diff(typ, prev[typ], next[typ])
+	}
+	for _, c := range d.Changes {
+		if c.Breaking {
+			d.Breaking = true
+		}
+	}
+	return d, nil
+}
+
+func (d *ABIDiff) diff(typ string, prev map[string]abiEntry, next map[string]abiEntry) {
+	// Errors are only used to decode reverts, changing them breaks nobody.
+	breaking := typ != "error"
+
+	var removed, added []string
+	for sig := range prev {
+		if _, ok := next[sig]; !ok {
+			removed = append(removed, sig)
+		}
+	}
+	for sig := range next {
+		if _, ok := prev[sig]; !ok {
+			added = append(added, sig)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+
+	paired := map[string]bool{}
+	for _, sig := range removed {
+		old := prev[sig]
+		c := &ABIChange{Type: typ, Name: old.name, Signature: sig, Breaking: breaking}
+
+		// A removed and an added entry of the same name are a changed one.
+		for _, candidate := range added {
+			if e := next[candidate]; !paired[candidate] && e.name == old.name {
+				paired[candidate] = true
+				c.Change = ABIChanged
+				c.Signature, c.OldSignature = candidate, sig
+				c.Message = fmt.Sprintf("%v %v changed to %v (%v %v -> %v)", typ, sig, candidate, idName(typ), old.id, e.id)
+				break
+			}
+		}
+		if c.Change == "" {
+			c.Change = ABIRemoved
+			c.Message = fmt.Sprintf("%v %v was removed", typ, sig)
+		}
+		d.Changes = append(d.Changes, c)
+	}
+
+	for _, sig := range added {
+		if paired[sig] {
+			continue
+		}
+		d.Changes = append(d.Changes, &ABIChange{
+			Type:      typ,
+			Change:    ABIAdded,
+			Name:      next[sig].name,
+			Signature: sig,
+			Message:   fmt.Sprintf("%v %v was added", typ, sig),
+		})
+	}
+
+	var kept []string
+	for sig := range prev {
+		if _, ok := next[sig]; ok {
+			kept = append(kept, sig)
+		}
+	}
+	sort.Strings(kept)
+	for _, sig := range kept {
+		if c := compareEntries(prev[sig], next[sig]); c != nil {
+			d.Changes = append(d.Changes, c)
+		}
+	}
+}
+
+// compareEntries compares entries with the same signature. Only return
+// values, mutability and indexed event parameters can still differ.
+func compareEntries(old abiEntry, next abiEntry) *ABIChange {
+	c := &ABIChange{Type: old.typ, Change: ABIChanged, Name: old.name, Signature: old.sig}
+
+	var notes []string
+	switch {
+	case old.outputs != next.outputs:
+		notes = append(notes, fmt.Sprintf("returns (%v) instead of (%v)", next.outputs, old.outputs))
+		c.Breaking = true
+	case old.indexed != next.indexed || old.anonymous != next.anonymous:
+		notes = append(notes, "indexed parameters changed")
+		c.Breaking = true
+	}
+	if old.constant && !next.constant {
+		notes = append(notes, "is no longer view")
+		c.Breaking = true
+	}
+	if !old.constant && next.constant {
+		notes = append(notes, "is now view")
+	}
+	if old.payable && !next.payable {
+		notes = append(notes, "is no longer payable")
+		c.Breaking = true
+	}
+	if !old.payable && next.payable {
+		notes = append(notes, "is now payable")
+	}
+
+	if len(notes) == 0 {
+		return nil
+	}
+	c.Message = fmt.Sprintf("%v %v %v", old.typ, old.sig, strings.Join(notes, ", "))
+	return c
+}
+
+func idName(typ string) string {
+	if typ == "event" {
+		return "topic"
+	}
+	return "selector"
+}
+
+// abiEntries returns the functions, events and errors of abiJSON keyed by
+// type and signature.
+func abiEntries(abiJSON string) (map[string]map[string]abiEntry, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]map[string]abiEntry{"function": {}, "event": {}, "error": {}}
+	for _, m := range parsed.Methods {
+		outputs := make([]string, len(m.Outputs))
+		for i, o := range m.Outputs {
+			outputs[i] = o.Type.String()
+		}
+		entries["function"][m.Sig()] = abiEntry{
+			typ:      "function",
+			name:     m.RawName,
+			sig:      m.Sig(),
+			id:       hexutil.Encode(m.ID()),
+			outputs:  strings.Join(outputs, ","),
+			constant: m.IsConstant(),
+			payable:  m.IsPayable(),
+		}
+	}
+	for _, e := range parsed.Events {
+		indexed := make([]string, len(e.Inputs))
+		for i, in := range e.Inputs {
+			indexed[i] = fmt.Sprint(in.Indexed)
+		}
+		entries["event"][e.Sig()] = abiEntry{
+			typ:       "event",
+			name:      e.RawName,
+			sig:       e.Sig(),
+			id:        e.ID().Hex(),
+			indexed:   strings.Join(indexed, ","),
+			anonymous: e.Anonymous,
+		}
+	}
+
+	errs, err := parseABIErrors(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	for selector, e := range errs {
+		sig := e.sig()
+		entries["error"][sig] = abiEntry{typ: "error", name: e.Name, sig: sig, id: hexutil.Encode([]byte(selector))}
+	}
+
+	return entries, nil
+}
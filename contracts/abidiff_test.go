@@ -0,0 +1,95 @@
+package contracts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffABI(t *testing.T) {
+	const base = `[
+		{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"type":"uint256"}],"stateMutability":"view"},
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false},
+		{"type":"error","name":"Insufficient","inputs":[{"name":"available","type":"uint256"}]}
+	]`
+	tests := []struct {
+		name         string
+		next         string
+		wantBreaking bool
+		wantChanges  []string
+	}{
+		{name: "identical", next: base, wantChanges: []string{}},
+		{
+			name: "function added",
+			next: `[
+				{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"type":"uint256"}],"stateMutability":"view"},
+				{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+				{"type":"function","name":"mint","inputs":[{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+				{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false},
+				{"type":"error","name":"Insufficient","inputs":[{"name":"available","type":"uint256"}]}
+			]`,
+			wantChanges: []string{"function added mint(uint256)"},
+		},
+		{
+			name: "inputs changed",
+			next: `[
+				{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"type":"uint256"}],"stateMutability":"view"},
+				{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint128"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+				{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false},
+				{"type":"error","name":"Insufficient","inputs":[{"name":"available","type":"uint256"}]}
+			]`,
+			wantBreaking: true,
+			wantChanges:  []string{"function changed transfer(address,uint128)"},
+		},
+		{
+			name: "outputs, mutability and indexing changed, event and error removed",
+			next: `[
+				{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"type":"uint128"}],"stateMutability":"nonpayable"},
+				{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"payable"},
+				{"type":"event","name":"Approval","inputs":[],"anonymous":false}
+			]`,
+			wantBreaking: true,
+			wantChanges: []string{
+				"function changed balanceOf(address)",
+				"function changed transfer(address,uint256)",
+				"event removed Transfer(address,address,uint256)",
+				"event added Approval()",
+				"error removed Insufficient(uint256)",
+			},
+		},
+		{
+			name: "only error changed",
+			next: `[
+				{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"type":"uint256"}],"stateMutability":"view"},
+				{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+				{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}],"anonymous":false},
+				{"type":"error","name":"Insufficient","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+			]`,
+			wantChanges: []string{"error changed Insufficient(uint256,uint256)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := DiffABI(base, tt.next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Breaking != tt.wantBreaking {
+				t.Errorf("Breaking = %v, want %v: %v", d.Breaking, tt.wantBreaking, d)
+			}
+			changes := []string{}
+			for _, c := range d.Changes {
+				changes = append(changes, c.Type+" "+c.Change+" "+c.Signature)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %v, want %v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestDiffABIInvalid(t *testing.T) {
+	if _, err := DiffABI(`[]`, `{`); err == nil {
+		t.Error("DiffABI accepted an invalid ABI")
+	}
+}
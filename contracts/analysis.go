@@ -0,0 +1,214 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxCodeSize is the runtime code size limit introduced by EIP-170.
+const MaxCodeSize = 24576
+
+// Analysis warning kinds
+const (
+	WarningSelfdestruct    = "selfdestruct"
+	WarningDelegatecall    = "delegatecall"
+	WarningCallcode        = "callcode"
+	WarningCodeSize        = "code-size"
+	WarningMissingFunction = "missing-function"
+	WarningExtraSelector   = "extra-selector"
+	WarningNoDispatcher    = "no-dispatcher"
+)
+
+// Opcodes the analysis looks for.
+const (
+	opEQ           = 0x14
+	opJUMPI        = 0x57
+	opPUSH1        = 0x60
+	opPUSH3        = 0x62
+	opPUSH4        = 0x63
+	opPUSH32       = 0x7f
+	opCALLCODE     = 0xf2
+	opDELEGATECALL = 0xf4
+	opSELFDESTRUCT = 0xff
+)
+
+// AnalysisWarning is a risky pattern found in runtime code.
+type AnalysisWarning struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	// Offsets of the opcodes in the runtime code.
+	Offsets []int `json:"offsets,omitempty"`
+}
+
+// BytecodeAnalysis is the result of disassembling the runtime code of a
+// contract.
+type BytecodeAnalysis struct {
+	Size int `json:"size"`
+	// Selectors dispatched by the code, as 0x prefixed hex.
+	Selectors []string `json:"selectors"`
+	// MissingFunctions are ABI functions whose selector is not dispatched.
+	MissingFunctions []string `json:"missingFunctions"`
+	// ExtraSelectors are dispatched but not in the ABI.
+	ExtraSelectors []string           `json:"extraSelectors"`
+	Warnings       []*AnalysisWarning `json:"warnings"`
+}
+
+// instruction is an opcode of disassembled code with its push data.
+type instruction struct {
+	offset int
+	op     byte
+	data   []byte
+}
+
+// disassemble splits code into instructions. Push data cut off by the end
+// of the code is returned as far as it goes.
+func disassemble(code []byte) []instruction {
+	var ins []instruction
+	for pc := 0; pc < len(code); pc++ {
+		in := instruction{offset: pc, op: code[pc]}
+		if in.op >= opPUSH1 && in.op <= opPUSH32 {
+			end := pc + 1 + int(in.op-opPUSH1+1)
+			if end > len(code) {
+				end = len(code)
+			}
+			in.data = code[pc+1 : end]
+			pc = end - 1
+		}
+		ins = append(ins, in)
+	}
+	return ins
+}
+
+// dispatchedSelectors returns the selectors solc's function dispatcher
+// compares the calldata with, found as PUSHn selector, EQ, PUSH tag, JUMPI.
+// solc pushes selectors with leading zero bytes in fewer than 4 bytes, so
+// PUSH1 to PUSH4 are accepted and padded on the left.
+func dispatchedSelectors(ins []instruction) []string {
+	seen := map[string]bool{}
+	selectors := []string{}
+	for i := 0; i+3 < len(ins); i++ {
+		if ins[i].op < opPUSH1 || ins[i].op > opPUSH4 || len(ins[i].data) != int(ins[i].op-opPUSH1+1) ||
+			ins[i+1].op != opEQ || ins[i+2].op < opPUSH1 || ins[i+2].op > opPUSH3 || ins[i+3].op != opJUMPI {
+			continue
+		}
+		sel := hexutil.Encode(common.LeftPadBytes(ins[i].data, 4))
+		if !seen[sel] {
+			seen[sel] = true
+			selectors = append(selectors, sel)
+		}
+	}
+	sort.Strings(selectors)
+	return selectors
+}
+
+// AnalyzeBytecode disassembles runtime code, leaving out the metadata solc
+// appends, and cross-checks the dispatched selectors with the functions of
+// abiJSON. The selectors are found by pattern, so code not compiled by
+// solc may report no dispatcher at all.
+func AnalyzeBytecode(code []byte, abiJSON string) (*BytecodeAnalysis, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	body, _ := SplitMetadata(code)
+	ins := disassemble(body)
+	a := &BytecodeAnalysis{
+		Size:             len(code),
+		Selectors:        dispatchedSelectors(ins),
+		MissingFunctions: []string{},
+		ExtraSelectors:   []string{},
+		Warnings:         []*AnalysisWarning{},
+	}
+
+	if len(code) > MaxCodeSize {
+		a.Warnings = append(a.Warnings, &AnalysisWarning{
+			Kind:    WarningCodeSize,
+			Message: fmt.Sprintf("runtime code is %d bytes, EIP-170 limits it to %d and the deployment will fail on mainnet", len(code), MaxCodeSize),
+		})
+	}
+
+	risky := []struct {
+		op      byte
+		kind    string
+		message string
+	}{
+		{opSELFDESTRUCT, WarningSelfdestruct, "SELFDESTRUCT can remove the contract and send its balance away"},
+		{opDELEGATECALL, WarningDelegatecall, "DELEGATECALL runs foreign code with the storage and balance of the contract"},
+		{opCALLCODE, WarningCallcode, "CALLCODE is deprecated and runs foreign code with the storage of the contract"},
+	}
+	for _, r := range risky {
+		var offsets []int
+		for _, in := range ins {
+			if in.op == r.op {
+				offsets = append(offsets, in.offset)
+			}
+		}
+		if len(offsets) > 0 {
+			a.Warnings = append(a.Warnings, &AnalysisWarning{Kind: r.kind, Message: r.message, Offsets: offsets})
+		}
+	}
+
+	functions := map[string]string{}
+	for _, m := range parsed.Methods {
+		functions[hexutil.Encode(m.ID())] = m.Sig()
+	}
+	if len(a.Selectors) == 0 {
+		if len(functions) > 0 {
+			a.Warnings = append(a.Warnings, &AnalysisWarning{
+				Kind:    WarningNoDispatcher,
+				Message: "no function dispatcher found, the ABI could not be checked against the code",
+			})
+		}
+		return a, nil
+	}
+
+	dispatched := map[string]bool{}
+	for _, sel := range a.Selectors {
+		dispatched[sel] = true
+		if _, ok := functions[sel]; !ok {
+			a.ExtraSelectors = append(a.ExtraSelectors, sel)
+			a.Warnings = append(a.Warnings, &AnalysisWarning{
+				Kind:    WarningExtraSelector,
+				Message: fmt.Sprintf("%v is dispatched but not in the ABI", sel),
+			})
+		}
+	}
+	for sel, sig := range functions {
+		if !dispatched[sel] {
+			a.MissingFunctions = append(a.MissingFunctions, sig)
+		}
+	}
+	sort.Strings(a.MissingFunctions)
+	for _, sig := range a.MissingFunctions {
+		a.Warnings = append(a.Warnings, &AnalysisWarning{
+			Kind:    WarningMissingFunction,
+			Message: fmt.Sprintf("%v is in the ABI but not dispatched by the code", sig),
+		})
+	}
+	return a, nil
+}
+
+// analyze stores the analysis of the runtime code of c. Contracts without
+// runtime code, e.g. artifacts of interfaces, are not analyzed.
+func (c *Contract) analyze() error {
+	if len(c.DeployedBytecode) == 0 {
+		return nil
+	}
+	a, err := AnalyzeBytecode(c.DeployedBytecode, string(c.ABI.RawMessage))
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	c.Analysis.RawMessage = b
+	return nil
+}
@@ -0,0 +1,128 @@
+package contracts
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const analysisTestABI = `[
+	{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}, {"name": "value", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+	{"type": "function", "name": "balanceOf", "inputs": [{"name": "owner", "type": "address"}], "outputs": [{"name": "", "type": "uint256"}]},
+	{"type": "event", "name": "Transfer", "inputs": [{"name": "from", "type": "address", "indexed": true}, {"name": "to", "type": "address", "indexed": true}, {"name": "value", "type": "uint256"}]}
+]`
+
+// dispatcher returns a solc style function dispatcher for selectors.
+func dispatcher(selectors ...string) []byte {
+	code := common.FromHex("60003560e01c")
+	for i, sel := range selectors {
+		code = append(code, 0x80, 0x63)
+		code = append(code, common.FromHex(sel)...)
+		code = append(code, 0x14, 0x61, 0x01, byte(i), 0x57)
+	}
+	return append(code, 0x60, 0x00, 0x80, 0xfd)
+}
+
+func TestAnalyzeBytecode(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          []byte
+		wantSelectors []string
+		wantMissing   []string
+		wantExtra     []string
+		wantWarnings  []string
+	}{
+		{
+			name:          "matching",
+			code:          dispatcher("a9059cbb", "70a08231"),
+			wantSelectors: []string{"0x70a08231", "0xa9059cbb"},
+		},
+		{
+			name:          "missing and extra",
+			code:          dispatcher("a9059cbb", "18160ddd", "a9059cbb"),
+			wantSelectors: []string{"0x18160ddd", "0xa9059cbb"},
+			wantMissing:   []string{"balanceOf(address)"},
+			wantExtra:     []string{"0x18160ddd"},
+			wantWarnings:  []string{WarningExtraSelector, WarningMissingFunction},
+		},
+		{
+			name:          "metadata is ignored",
+			code:          append(dispatcher("a9059cbb", "70a08231"), common.FromHex("a165627a7a72305820"+"f4ff63a9059cbb1461010057"+strings.Repeat("00", 20)+"0029")...),
+			wantSelectors: []string{"0x70a08231", "0xa9059cbb"},
+		},
+		{
+			name:          "risky opcodes",
+			code:          append(dispatcher("a9059cbb", "70a08231"), 0xf4, 0xf2, 0xff, 0x61, 0xff, 0xf4),
+			wantSelectors: []string{"0x70a08231", "0xa9059cbb"},
+			wantWarnings:  []string{WarningSelfdestruct, WarningDelegatecall, WarningCallcode},
+		},
+		{
+			name:         "no dispatcher",
+			code:         common.FromHex("6080604052600080fd"),
+			wantWarnings: []string{WarningNoDispatcher},
+		},
+		{
+			name:          "too large",
+			code:          append(dispatcher("a9059cbb", "70a08231"), bytes.Repeat([]byte{0x5b}, MaxCodeSize)...),
+			wantSelectors: []string{"0x70a08231", "0xa9059cbb"},
+			wantWarnings:  []string{WarningCodeSize},
+		},
+		{
+			name:          "short selectors",
+			code:          common.FromHex("60003560e01c" + "80600114610100" + "57" + "8061abcd14610101" + "57" + "806212345614610102" + "57" + "8063a9059cbb14610103" + "57" + "600080fd"),
+			wantSelectors: []string{"0x00000001", "0x0000abcd", "0x00123456", "0xa9059cbb"},
+			wantMissing:   []string{"balanceOf(address)"},
+			wantExtra:     []string{"0x00000001", "0x0000abcd", "0x00123456"},
+			wantWarnings:  []string{WarningExtraSelector, WarningExtraSelector, WarningExtraSelector, WarningMissingFunction},
+		},
+		{
+			name:         "truncated push",
+			code:         common.FromHex("63a9059c"),
+			wantWarnings: []string{WarningNoDispatcher},
+		},
+	}
+	for _, tt := range tests {
+		a, err := AnalyzeBytecode(tt.code, analysisTestABI)
+		if err != nil {
+			t.Errorf("%v: AnalyzeBytecode error = %v", tt.name, err)
+			continue
+		}
+		var warnings []string
+		for _, w := range a.Warnings {
+			warnings = append(warnings, w.Kind)
+		}
+		if a.Size != len(tt.code) ||
+			!reflect.DeepEqual(a.Selectors, nonNil(tt.wantSelectors)) ||
+			!reflect.DeepEqual(a.MissingFunctions, nonNil(tt.wantMissing)) ||
+			!reflect.DeepEqual(a.ExtraSelectors, nonNil(tt.wantExtra)) ||
+			!reflect.DeepEqual(warnings, tt.wantWarnings) {
+			t.Errorf("%v: AnalyzeBytecode = size %d, selectors %v, missing %v, extra %v, warnings %v", tt.name, a.Size, a.Selectors, a.MissingFunctions, a.ExtraSelectors, warnings)
+		}
+	}
+
+	if _, err := AnalyzeBytecode(dispatcher(), "not an ABI"); err == nil {
+		t.Error("AnalyzeBytecode with an invalid ABI succeeded")
+	}
+}
+
+func TestAnalyzeBytecodeOffsets(t *testing.T) {
+	code := append(dispatcher("a9059cbb", "70a08231"), 0xf4, 0x60, 0xf4, 0xf4)
+	a, err := AnalyzeBytecode(code, analysisTestABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(code)
+	if len(a.Warnings) != 1 || !reflect.DeepEqual(a.Warnings[0].Offsets, []int{n - 4, n - 1}) {
+		t.Errorf("warnings = %+v, want delegatecall at %d and %d", a.Warnings, n-4, n-1)
+	}
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
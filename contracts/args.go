@@ -0,0 +1,237 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ConvertArgs converts JSON encoded arguments into the Go values expected
+// by the abi package when packing the given arguments.
+//
+// Integers may be given as JSON numbers or as decimal or 0x prefixed hex
+// strings, bytes as 0x prefixed hex strings and tuples as JSON arrays or
+// objects keyed by component name.
+func ConvertArgs(args abi.Arguments, raw []json.RawMessage) ([]interface{}, error) {
+	if len(args) != len(raw) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(args), len(raw))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		v, err := convertArg(arg.Type, raw[i])
+		if err != nil {
+			name := arg.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i)
+			}
+			return nil, fmt.Errorf("argument %v: %v", name, err)
+		}
+		values[i] = v.Interface()
+	}
+	return values, nil
+}
+
+// FindMethod returns the method of parsed named either by its name or, for
+// overloaded methods, by its signature such as initialize(address,uint256).
+func FindMethod(parsed abi.ABI, name string) (abi.Method, error) {
+	if m, ok := parsed.Methods[name]; ok && !strings.Contains(name, "(") {
+		return m, nil
+	}
+	for _, m := range parsed.Methods {
+		if m.Sig() == strings.Replace(name, " ", "", -1) {
+			return m, nil
+		}
+	}
+	return abi.Method{}, fmt.Errorf("method %v not found", name)
+}
+
+// PackCall returns the calldata for calling the named method of parsed with
+// JSON encoded arguments.
+func PackCall(parsed abi.ABI, name string, raw []json.RawMessage) ([]byte, error) {
+	m, err := FindMethod(parsed, name)
+	if err != nil {
+		return nil, err
+	}
+	args, err := ConvertArgs(m.Inputs, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", m.Sig(), err)
+	}
+	packed, err := m.Inputs.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", m.Sig(), err)
+	}
+	return append(m.ID(), packed...), nil
+}
+
+func convertArg(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := parseInteger(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if t.T == abi.UintTy && n.Sign() < 0 {
+			return reflect.Value{}, fmt.Errorf("negative value for %v", t)
+		}
+		if !fitsInteger(t, n) {
+			return reflect.Value{}, fmt.Errorf("value overflows %v", t)
+		}
+		if t.Type == reflect.TypeOf(&big.Int{}) {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(t.Type).Elem()
+		if t.T == abi.IntTy {
+			v.SetInt(n.Int64())
+		} else {
+			v.SetUint(n.Uint64())
+		}
+		return v, nil
+
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(s), nil
+
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		if !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("invalid address %q", s)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+
+	case abi.BytesTy, abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf(b), nil
+		}
+		v := reflect.New(t.Type).Elem()
+		if len(b) > v.Len() {
+			return reflect.Value{}, fmt.Errorf("value longer than %v", t)
+		}
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v, nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return reflect.Value{}, err
+		}
+		var v reflect.Value
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(t.Type, len(items), len(items))
+		} else {
+			if len(items) != t.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d items for %v, got %d", t.Size, t, len(items))
+			}
+			v = reflect.New(t.Type).Elem()
+		}
+		for i, item := range items {
+			e, err := convertArg(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("item %d: %v", i, err)
+			}
+			v.Index(i).Set(e)
+		}
+		return v, nil
+
+	case abi.TupleTy:
+		items, err := tupleItems(t, raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(t.Type).Elem()
+		for i, elem := range t.TupleElems {
+			e, err := convertArg(*elem, items[i])
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%v: %v", t.TupleRawNames[i], err)
+			}
+			v.Field(i).Set(e)
+		}
+		return v, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("unsupported type %v", t)
+}
+
+// parseInteger accepts JSON numbers and decimal or 0x prefixed hex strings.
+// Leading zeros are decimal, not octal.
+func parseInteger(raw json.RawMessage) (*big.Int, error) {
+	s := strings.Trim(string(raw), `"`)
+	digits, base := strings.TrimPrefix(s, "-"), 10
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits, base = digits[2:], 16
+	}
+	if digits == "" || digits[0] == '+' || digits[0] == '-' {
+		return nil, fmt.Errorf("invalid integer %v", string(raw))
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %v", string(raw))
+	}
+	if strings.HasPrefix(s, "-") {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// fitsInteger reports whether n is in the range of the integer type t,
+// -2^(size-1) <= n < 2^(size-1) for signed and 0 <= n < 2^size for unsigned
+// types.
+func fitsInteger(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	return n.Cmp(limit) < 0 && n.Cmp(new(big.Int).Neg(limit)) >= 0
+}
+
+// tupleItems returns tuple components in order from a JSON array or object.
+func tupleItems(t abi.Type, raw json.RawMessage) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err == nil {
+		if len(items) != len(t.TupleElems) {
+			return nil, fmt.Errorf("expected %d components for %v, got %d", len(t.TupleElems), t, len(items))
+		}
+		return items, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("expected array or object for %v", t)
+	}
+	items = make([]json.RawMessage, len(t.TupleRawNames))
+	for i, name := range t.TupleRawNames {
+		f, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("missing component %v", name)
+		}
+		items[i] = f
+	}
+	return items, nil
+}
@@ -0,0 +1,92 @@
+package contracts
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// argsOf returns the arguments of a constructor with the given inputs.
+func argsOf(t *testing.T, inputs string) abi.Arguments {
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"constructor","inputs":` + inputs + `}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Constructor.Inputs
+}
+
+func TestConvertArgs(t *testing.T) {
+	tuple := `[{"name":"p","type":"tuple","components":[{"name":"owner","type":"address"},{"name":"amount","type":"uint64"}]}]`
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tests := []struct {
+		name    string
+		inputs  string
+		raw     string
+		want    []interface{}
+		wantErr bool
+	}{
+		{name: "uint256 number", inputs: `[{"type":"uint256"}]`, raw: `[42]`, want: []interface{}{big.NewInt(42)}},
+		{name: "uint256 decimal string", inputs: `[{"type":"uint256"}]`, raw: `["1000000000000000000000"]`, want: []interface{}{new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))}},
+		{name: "uint256 hex string", inputs: `[{"type":"uint256"}]`, raw: `["0xff"]`, want: []interface{}{big.NewInt(255)}},
+		{name: "uint8", inputs: `[{"type":"uint8"}]`, raw: `[255]`, want: []interface{}{uint8(255)}},
+		{name: "uint8 overflow", inputs: `[{"type":"uint8"}]`, raw: `[256]`, wantErr: true},
+		{name: "uint negative", inputs: `[{"type":"uint32"}]`, raw: `[-1]`, wantErr: true},
+		{name: "int8", inputs: `[{"type":"int8"}]`, raw: `[-127]`, want: []interface{}{int8(-127)}},
+		{name: "int8 overflow", inputs: `[{"type":"int8"}]`, raw: `[128]`, wantErr: true},
+		{name: "int8 minimum", inputs: `[{"type":"int8"}]`, raw: `[-128]`, want: []interface{}{int8(-128)}},
+		{name: "int8 underflow", inputs: `[{"type":"int8"}]`, raw: `[-129]`, wantErr: true},
+		{name: "int8 negative hex", inputs: `[{"type":"int8"}]`, raw: `["-0x80"]`, want: []interface{}{int8(-128)}},
+		{name: "int256 minimum", inputs: `[{"type":"int256"}]`, raw: `["-57896044618658097711785492504343953926634992332820282019728792003956564819968"]`, want: []interface{}{new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))}},
+		{name: "int256 overflow", inputs: `[{"type":"int256"}]`, raw: `["57896044618658097711785492504343953926634992332820282019728792003956564819968"]`, wantErr: true},
+		{name: "leading zeros are decimal", inputs: `[{"type":"uint256"}]`, raw: `["010"]`, want: []interface{}{big.NewInt(10)}},
+		{name: "uppercase hex prefix", inputs: `[{"type":"uint256"}]`, raw: `["0XFF"]`, want: []interface{}{big.NewInt(255)}},
+		{name: "binary prefix", inputs: `[{"type":"uint256"}]`, raw: `["0b101"]`, wantErr: true},
+		{name: "underscores", inputs: `[{"type":"uint256"}]`, raw: `["1_000"]`, wantErr: true},
+		{name: "double sign", inputs: `[{"type":"int256"}]`, raw: `["--1"]`, wantErr: true},
+		{name: "bare hex prefix", inputs: `[{"type":"uint256"}]`, raw: `["0x"]`, wantErr: true},
+		{name: "invalid integer", inputs: `[{"type":"uint256"}]`, raw: `["ten"]`, wantErr: true},
+		{name: "bool", inputs: `[{"type":"bool"}]`, raw: `[true]`, want: []interface{}{true}},
+		{name: "string", inputs: `[{"type":"string"}]`, raw: `["v1.0"]`, want: []interface{}{"v1.0"}},
+		{name: "address", inputs: `[{"type":"address"}]`, raw: `["0x00000000000000000000000000000000000000aa"]`, want: []interface{}{owner}},
+		{name: "invalid address", inputs: `[{"type":"address"}]`, raw: `["0xaa"]`, wantErr: true},
+		{name: "bytes", inputs: `[{"type":"bytes"}]`, raw: `["0x0102"]`, want: []interface{}{[]byte{1, 2}}},
+		{name: "bytes4", inputs: `[{"type":"bytes4"}]`, raw: `["0x0102"]`, want: []interface{}{[4]byte{1, 2}}},
+		{name: "bytes4 too long", inputs: `[{"type":"bytes4"}]`, raw: `["0x0102030405"]`, wantErr: true},
+		{name: "bytes without 0x", inputs: `[{"type":"bytes"}]`, raw: `["0102"]`, wantErr: true},
+		{name: "slice", inputs: `[{"type":"uint16[]"}]`, raw: `[[1, "0x2"]]`, want: []interface{}{[]uint16{1, 2}}},
+		{name: "array", inputs: `[{"type":"bool[2]"}]`, raw: `[[true, false]]`, want: []interface{}{[2]bool{true, false}}},
+		{name: "array wrong length", inputs: `[{"type":"bool[2]"}]`, raw: `[[true]]`, wantErr: true},
+		{name: "argument count", inputs: `[{"type":"bool"}]`, raw: `[true, false]`, wantErr: true},
+		{name: "tuple array", inputs: tuple, raw: `[["0x00000000000000000000000000000000000000aa", 7]]`},
+		{name: "tuple object", inputs: tuple, raw: `[{"owner": "0x00000000000000000000000000000000000000aa", "amount": 7}]`},
+		{name: "tuple missing component", inputs: tuple, raw: `[{"owner": "0x00000000000000000000000000000000000000aa"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := argsOf(t, tt.inputs)
+			var raw []json.RawMessage
+			if err := json.Unmarshal([]byte(tt.raw), &raw); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ConvertArgs(args, raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertArgs = %#v, want %#v", got, tt.want)
+			}
+			// The values must be accepted by the abi package.
+			if _, err := args.Pack(got...); err != nil {
+				t.Errorf("Pack: %v", err)
+			}
+		})
+	}
+}
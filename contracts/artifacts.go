@@ -0,0 +1,305 @@
+package contracts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Artifact is a single compiled contract extracted from a Hardhat or
+// Truffle artifact or from solc --standard-json output.
+type Artifact struct {
+	Name                   string
+	SourceName             string
+	ABI                    json.RawMessage
+	Bytecode               []byte
+	DeployedBytecode       []byte
+	LinkReferences         LinkReferences
+	DeployedLinkReferences LinkReferences
+	ImmutableReferences    ImmutableReferences
+	CompilerVersion        string
+	Metadata               json.RawMessage
+	StorageLayout          json.RawMessage
+}
+
+// LinkReferences maps source names to library names to the positions of
+// the library address placeholders in the bytecode, as in solc output.
+type LinkReferences map[string]map[string][]LinkReference
+
+// LinkReference is the byte offset and length of a library address placeholder.
+type LinkReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// ImmutableReferences maps the AST IDs of immutable variables to their
+// positions in the runtime bytecode, as in solc output.
+type ImmutableReferences map[string][]LinkReference
+
+// Libraries returns the fully qualified names of all referenced libraries.
+func (l LinkReferences) Libraries() []string {
+	var libs []string
+	for source, refs := range l {
+		for lib := range refs {
+			libs = append(libs, qualifiedName(source, lib))
+		}
+	}
+	sort.Strings(libs)
+	return libs
+}
+
+func qualifiedName(source string, name string) string {
+	if source == "" {
+		return name
+	}
+	return source + ":" + name
+}
+
+// ParseArtifacts extracts all contracts from a Hardhat or Truffle artifact,
+// a JSON array of such artifacts or solc --standard-json output.
+func ParseArtifacts(data []byte) ([]*Artifact, error) {
+	data = []byte(strings.TrimSpace(string(data)))
+	if len(data) > 0 && data[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		var artifacts []*Artifact
+		for i, item := range items {
+			a, err := parseArtifact(item)
+			if err != nil {
+				return nil, fmt.Errorf("artifact %d: %v", i, err)
+			}
+			artifacts = append(artifacts, a)
+		}
+		return artifacts, nil
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+	if _, ok := probe["contracts"]; ok {
+		return parseStandardJSON(data)
+	}
+
+	a, err := parseArtifact(data)
+	if err != nil {
+		return nil, err
+	}
+	return []*Artifact{a}, nil
+}
+
+// mustParseArtifact parses a single artifact generated by
+// solidity/generate.go. It panics if data is invalid.
+func mustParseArtifact(data string) *Artifact {
+	a, err := parseArtifact([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// hardhatTruffleArtifact covers the fields shared by Hardhat
+// (hh-sol-artifact-1) and Truffle artifacts.
+type hardhatTruffleArtifact struct {
+	ContractName           string              `json:"contractName"`
+	SourceName             string              `json:"sourceName"`
+	SourcePath             string              `json:"sourcePath"`
+	ABI                    json.RawMessage     `json:"abi"`
+	Bytecode               string              `json:"bytecode"`
+	DeployedBytecode       string              `json:"deployedBytecode"`
+	LinkReferences         LinkReferences      `json:"linkReferences"`
+	DeployedLinkReferences LinkReferences      `json:"deployedLinkReferences"`
+	ImmutableReferences    ImmutableReferences `json:"immutableReferences"`
+	Metadata               string              `json:"metadata"`
+	StorageLayout          json.RawMessage     `json:"storageLayout"`
+	Compiler               struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+}
+
+func parseArtifact(data []byte) (*Artifact, error) {
+	var h hardhatTruffleArtifact
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, err
+	}
+	if h.ContractName == "" || len(h.ABI) == 0 {
+		return nil, errors.New("unrecognised artifact format")
+	}
+
+	a := &Artifact{
+		Name:                h.ContractName,
+		SourceName:          h.SourceName,
+		ABI:                 h.ABI,
+		CompilerVersion:     h.Compiler.Version,
+		StorageLayout:       h.StorageLayout,
+		ImmutableReferences: h.ImmutableReferences,
+	}
+	if a.SourceName == "" {
+		a.SourceName = h.SourcePath
+	}
+
+	var err error
+	if a.Bytecode, a.LinkReferences, err = DecodeBytecode(h.Bytecode, h.LinkReferences); err != nil {
+		return nil, fmt.Errorf("bytecode: %v", err)
+	}
+	if a.DeployedBytecode, a.DeployedLinkReferences, err = DecodeBytecode(h.DeployedBytecode, h.DeployedLinkReferences); err != nil {
+		return nil, fmt.Errorf("deployed bytecode: %v", err)
+	}
+	if err := a.setMetadata(h.Metadata); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// standardJSONOutput is the subset of solc --standard-json output used.
+type standardJSONOutput struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		ABI           json.RawMessage `json:"abi"`
+		Metadata      string          `json:"metadata"`
+		StorageLayout json.RawMessage `json:"storageLayout"`
+		EVM           struct {
+			Bytecode         standardJSONBytecode `json:"bytecode"`
+			DeployedBytecode struct {
+				standardJSONBytecode
+				ImmutableReferences ImmutableReferences `json:"immutableReferences"`
+			} `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+type standardJSONBytecode struct {
+	Object         string         `json:"object"`
+	LinkReferences LinkReferences `json:"linkReferences"`
+}
+
+func parseStandardJSON(data []byte) ([]*Artifact, error) {
+	var out standardJSONOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	for _, e := range out.Errors {
+		if e.Severity == "error" {
+			return nil, fmt.Errorf("compilation failed: %v", e.FormattedMessage)
+		}
+	}
+
+	sources := make([]string, 0, len(out.Contracts))
+	for source := range out.Contracts {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	var artifacts []*Artifact
+	for _, source := range sources {
+		names := make([]string, 0, len(out.Contracts[source]))
+		for name := range out.Contracts[source] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			c := out.Contracts[source][name]
+			a := &Artifact{
+				Name:                name,
+				SourceName:          source,
+				ABI:                 c.ABI,
+				StorageLayout:       c.StorageLayout,
+				ImmutableReferences: c.EVM.DeployedBytecode.ImmutableReferences,
+			}
+
+			var err error
+			if a.Bytecode, a.LinkReferences, err = DecodeBytecode(c.EVM.Bytecode.Object, c.EVM.Bytecode.LinkReferences); err != nil {
+				return nil, fmt.Errorf("%v: bytecode: %v", qualifiedName(source, name), err)
+			}
+			if a.DeployedBytecode, a.DeployedLinkReferences, err = DecodeBytecode(c.EVM.DeployedBytecode.Object, c.EVM.DeployedBytecode.LinkReferences); err != nil {
+				return nil, fmt.Errorf("%v: deployed bytecode: %v", qualifiedName(source, name), err)
+			}
+			if err := a.setMetadata(c.Metadata); err != nil {
+				return nil, fmt.Errorf("%v: %v", qualifiedName(source, name), err)
+			}
+
+			artifacts = append(artifacts, a)
+		}
+	}
+
+	return artifacts, nil
+}
+
+// setMetadata stores the solc metadata JSON and takes the compiler
+// version from it when the artifact did not provide one.
+func (a *Artifact) setMetadata(metadata string) error {
+	if metadata == "" {
+		return nil
+	}
+
+	var m struct {
+		Compiler struct {
+			Version string `json:"version"`
+		} `json:"compiler"`
+	}
+	if err := json.Unmarshal([]byte(metadata), &m); err != nil {
+		return fmt.Errorf("invalid metadata: %v", err)
+	}
+
+	a.Metadata = json.RawMessage(metadata)
+	if a.CompilerVersion == "" {
+		a.CompilerVersion = m.Compiler.Version
+	}
+	return nil
+}
+
+// placeholderLength is the length in hex characters of a library address
+// placeholder such as __$53aea86b7d70b31448b230b20ae141a537$__ or the
+// legacy __LibraryName__________________________.
+const placeholderLength = 40
+
+// DecodeBytecode decodes hex bytecode which may contain library address
+// placeholders. Placeholders are zero filled and, when refs is empty,
+// their positions are returned as link references keyed by the name in
+// the placeholder.
+func DecodeBytecode(code string, refs LinkReferences) ([]byte, LinkReferences, error) {
+	code = strings.TrimPrefix(strings.TrimSpace(code), "0x")
+	if code == "" {
+		return nil, refs, nil
+	}
+
+	found := LinkReferences{}
+	var clean strings.Builder
+	for i := 0; i < len(code); {
+		if strings.HasPrefix(code[i:], "__") {
+			if i+placeholderLength > len(code) {
+				return nil, nil, errors.New("truncated library placeholder")
+			}
+			name := strings.Trim(code[i:i+placeholderLength], "_$")
+			if found[""] == nil {
+				found[""] = map[string][]LinkReference{}
+			}
+			found[""][name] = append(found[""][name], LinkReference{Start: i / 2, Length: placeholderLength / 2})
+			clean.WriteString(strings.Repeat("0", placeholderLength))
+			i += placeholderLength
+			continue
+		}
+		clean.WriteByte(code[i])
+		i++
+	}
+
+	b, err := hex.DecodeString(clean.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(refs) == 0 && len(found) > 0 {
+		refs = found
+	}
+	return b, refs, nil
+}
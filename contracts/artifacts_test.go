@@ -0,0 +1,106 @@
+package contracts
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseArtifacts(t *testing.T) {
+	const hardhat = `{
+		"_format": "hh-sol-artifact-1",
+		"contractName": "Token",
+		"sourceName": "contracts/Token.sol",
+		"abi": [],
+		"bytecode": "0x6001",
+		"deployedBytecode": "0x6002",
+		"linkReferences": {},
+		"deployedLinkReferences": {}
+	}`
+	const truffle = `{
+		"contractName": "Linked",
+		"sourcePath": "/project/contracts/Linked.sol",
+		"abi": [],
+		"bytecode": "0x73__Math__________________________________6001",
+		"deployedBytecode": "0x",
+		"metadata": "{\"compiler\":{\"version\":\"0.6.6+commit.6c089d02\"}}"
+	}`
+	const standardJSON = `{
+		"contracts": {
+			"b.sol": {"B": {"abi": [], "evm": {"bytecode": {"object": "6003"}, "deployedBytecode": {"object": "6004"}}}},
+			"a.sol": {
+				"Z": {"abi": [], "evm": {"bytecode": {"object": "6005"}, "deployedBytecode": {"object": ""}}},
+				"A": {"abi": [], "evm": {"bytecode": {"object": "73__$53aea86b7d70b31448b230b20ae141a537$__", "linkReferences": {"a.sol": {"Math": [{"start": 1, "length": 20}]}}}, "deployedBytecode": {"object": ""}}}
+			}
+		},
+		"errors": [{"severity": "warning", "formattedMessage": "unused variable"}]
+	}`
+
+	tests := []struct {
+		name      string
+		data      string
+		wantNames []string
+		wantErr   bool
+	}{
+		{name: "hardhat", data: hardhat, wantNames: []string{"Token"}},
+		{name: "truffle", data: truffle, wantNames: []string{"Linked"}},
+		{name: "array", data: "[" + hardhat + "," + truffle + "]", wantNames: []string{"Token", "Linked"}},
+		{name: "standard json sorted by source and name", data: standardJSON, wantNames: []string{"A", "Z", "B"}},
+		{name: "compilation error", data: `{"contracts": {}, "errors": [{"severity": "error", "formattedMessage": "ParserError"}]}`, wantErr: true},
+		{name: "unrecognised", data: `{"name": "Token"}`, wantErr: true},
+		{name: "invalid hex", data: `{"contractName": "T", "abi": [], "bytecode": "0x6g"}`, wantErr: true},
+		{name: "truncated placeholder", data: `{"contractName": "T", "abi": [], "bytecode": "0x73__Math__"}`, wantErr: true},
+		{name: "invalid metadata", data: `{"contractName": "T", "abi": [], "metadata": "{"}`, wantErr: true},
+		{name: "not json", data: `6001`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseArtifacts([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var names []string
+			for _, a := range got {
+				names = append(names, a.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestParseArtifactsFields(t *testing.T) {
+	got, err := ParseArtifacts([]byte(`{
+		"contractName": "Linked",
+		"sourcePath": "/project/contracts/Linked.sol",
+		"abi": [],
+		"bytecode": "0x73__Math__________________________________6001",
+		"deployedBytecode": "6002",
+		"metadata": "{\"compiler\":{\"version\":\"0.6.6+commit.6c089d02\"}}"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := got[0]
+	if a.SourceName != "/project/contracts/Linked.sol" {
+		t.Errorf("SourceName = %v", a.SourceName)
+	}
+	if a.CompilerVersion != "0.6.6+commit.6c089d02" {
+		t.Errorf("CompilerVersion = %v", a.CompilerVersion)
+	}
+	want := append(append([]byte{0x73}, make([]byte, 20)...), 0x60, 0x01)
+	if !bytes.Equal(a.Bytecode, want) {
+		t.Errorf("Bytecode = %x, want %x", a.Bytecode, want)
+	}
+	if !bytes.Equal(a.DeployedBytecode, []byte{0x60, 0x02}) {
+		t.Errorf("DeployedBytecode = %x", a.DeployedBytecode)
+	}
+	wantRefs := LinkReferences{"": {"Math": {{Start: 1, Length: 20}}}}
+	if !reflect.DeepEqual(a.LinkReferences, wantRefs) {
+		t.Errorf("LinkReferences = %v, want %v", a.LinkReferences, wantRefs)
+	}
+	if libs := a.LinkReferences.Libraries(); !reflect.DeepEqual(libs, []string{"Math"}) {
+		t.Errorf("Libraries = %v", libs)
+	}
+}
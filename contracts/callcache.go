@@ -0,0 +1,383 @@
+package contracts
+
+import (
+	"context"
+	"encoding/binary"
+	"log"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// Call cache backends.
+const (
+	CallStoreMemory   = "memory"
+	CallStorePostgres = "postgres"
+)
+
+// callFinality is how many blocks below the head a read must be to be
+// cached permanently. Reads of more recent blocks may be reorganised and
+// are dropped with the reads of the latest block.
+const callFinality = 12
+
+// headPollInterval is how often heads are polled on networks without
+// subscriptions, headIdleTimeout how long heads are followed after the
+// last read and headRetryDelay how long to wait after losing the network.
+const (
+	headPollInterval = 4 * time.Second
+	headIdleTimeout  = 5 * time.Minute
+	headRetryDelay   = 10 * time.Second
+)
+
+// CallStore stores the results of reads of final blocks, which never
+// change.
+type CallStore interface {
+	Get(key common.Hash) ([]byte, bool, error)
+	Put(key common.Hash, out []byte) error
+}
+
+// MemoryCallStore is a CallStore in memory. Once it holds max results it
+// drops an arbitrary one for every result added.
+type MemoryCallStore struct {
+	mu      sync.RWMutex
+	max     int
+	results map[common.Hash][]byte
+}
+
+// NewMemoryCallStore returns an empty MemoryCallStore holding at most max
+// results.
+func NewMemoryCallStore(max int) *MemoryCallStore {
+	return &MemoryCallStore{max: max, results: map[common.Hash][]byte{}}
+}
+
+// Get implements the CallStore interface.
+func (s *MemoryCallStore) Get(key common.Hash) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out, ok := s.results[key]
+	return out, ok, nil
+}
+
+// Put implements the CallStore interface.
+func (s *MemoryCallStore) Put(key common.Hash, out []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.results[key]; !ok && len(s.results) >= s.max {
+		for k := range s.results {
+			delete(s.results, k)
+			break
+		}
+	}
+	s.results[key] = out
+	return nil
+}
+
+// CallResult is the stored result of a read by a PostgresCallStore.
+type CallResult struct {
+	helpers.BaseModel
+	Hash   string `gorm:"unique_index"`
+	Output []byte
+}
+
+// PostgresCallStore is a CallStore in the database, which survives
+// restarts and is shared by every instance.
+type PostgresCallStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresCallStore returns a CallStore in db.
+func NewPostgresCallStore(db *gorm.DB) *PostgresCallStore {
+	return &PostgresCallStore{db: db}
+}
+
+// Get implements the CallStore interface.
+func (s *PostgresCallStore) Get(key common.Hash) ([]byte, bool, error) {
+	r := &CallResult{}
+	if err := s.db.Where("hash = ?", key.Hex()).First(r).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return r.Output, true, nil
+}
+
+// Put implements the CallStore interface.
+func (s *PostgresCallStore) Put(key common.Hash, out []byte) error {
+	return s.db.Where(CallResult{Hash: key.Hex()}).Attrs(CallResult{Output: out}).FirstOrCreate(&CallResult{}).Error
+}
+
+// CallCache caches the results of contract reads by chain ID, contract,
+// calldata and block. Reads of final blocks go to a CallStore. Reads of
+// the latest and recent blocks are held in memory until the chain has a
+// new head, so the cache follows the heads of every chain it serves.
+type CallCache struct {
+	// hits and misses are updated atomically and come first to be
+	// aligned on 32 bit platforms.
+	hits   uint64
+	misses uint64
+
+	store   CallStore
+	backend string
+	dial    func(network string) (*ethclient.Client, error)
+
+	mu       sync.Mutex
+	chainIDs map[string]uint64
+	heads    map[uint64]*chainHead
+}
+
+// chainHead is the head of a chain and the reads made at it.
+type chainHead struct {
+	following bool
+	lastRead  time.Time
+	header    *types.Header
+	recent    map[common.Hash][]byte
+	// generation counts the heads, so reads which raced a new one are
+	// not kept as reads of it.
+	generation uint64
+}
+
+// CallCacheStats reports how many reads were answered from the cache.
+type CallCacheStats struct {
+	Backend string `json:"backend"`
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+}
+
+// NewCallCache returns a CallCache storing final reads in store, named
+// backend in its stats. dial connects to a network to follow its heads.
+func NewCallCache(store CallStore, backend string, dial func(network string) (*ethclient.Client, error)) *CallCache {
+	return &CallCache{
+		store:    store,
+		backend:  backend,
+		dial:     dial,
+		chainIDs: map[string]uint64{},
+		heads:    map[uint64]*chainHead{},
+	}
+}
+
+// Stats returns the hits and misses since the cache was created.
+func (c *CallCache) Stats() *CallCacheStats {
+	return &CallCacheStats{
+		Backend: c.backend,
+		Hits:    atomic.LoadUint64(&c.hits),
+		Misses:  atomic.LoadUint64(&c.misses),
+	}
+}
+
+// Client returns client, connected to network, with its reads going
+// through the cache.
+func (c *CallCache) Client(client *rpc.Client, network string) *Client {
+	return &Client{Client: ethclient.NewClient(client), rpc: client, network: network, cache: c}
+}
+
+// Client is a connection to a network. Its contract reads go through a
+// CallCache, if it has one.
+type Client struct {
+	*ethclient.Client
+	rpc     *rpc.Client
+	network string
+	cache   *CallCache
+}
+
+// CallContract executes msg at block, the latest if nil, like
+// ethclient.Client.CallContract, but errors keep the revert data
+// RevertFromError decodes. Plain reads are answered from the cache when
+// possible, calls with a sender, value or gas limit are not cached.
+func (c *Client) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if c.cache == nil || msg.To == nil || msg.From != (common.Address{}) || msg.Gas != 0 || msg.GasPrice != nil || msg.Value != nil {
+		return callRPC(ctx, c.rpc, msg, block)
+	}
+	return c.cache.call(ctx, c, msg, block)
+}
+
+// call answers msg from the cache or the network, storing the result.
+func (c *CallCache) call(ctx context.Context, client *Client, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	chainID, err := c.chainID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	key := callKey(chainID, *msg.To, msg.Data, block)
+
+	c.mu.Lock()
+	head := c.follow(client.network, chainID)
+	final := block != nil && head.header != nil && new(big.Int).Sub(head.header.Number, block).Cmp(big.NewInt(callFinality)) >= 0
+	generation := head.generation
+	out, ok := head.recent[key]
+	c.mu.Unlock()
+
+	if !ok && final {
+		if out, ok, err = c.store.Get(key); err != nil {
+			log.Printf("Call cache: %v", err)
+		}
+	}
+	if ok {
+		atomic.AddUint64(&c.hits, 1)
+		return out, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	if out, err = callRPC(ctx, client.rpc, msg, block); err != nil {
+		return nil, err
+	}
+
+	if final {
+		if err := c.store.Put(key, out); err != nil {
+			log.Printf("Call cache: %v", err)
+		}
+		return out, nil
+	}
+	c.mu.Lock()
+	if head.recent != nil && head.generation == generation {
+		head.recent[key] = out
+	}
+	c.mu.Unlock()
+	return out, nil
+}
+
+// chainID returns the chain ID of the client's network, asking the network
+// once.
+func (c *CallCache) chainID(ctx context.Context, client *Client) (uint64, error) {
+	c.mu.Lock()
+	id, ok := c.chainIDs[client.network]
+	c.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	n, err := client.ChainID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	c.chainIDs[client.network] = n.Uint64()
+	c.mu.Unlock()
+	return n.Uint64(), nil
+}
+
+// follow returns the head of the chain, starting to follow it on network
+// if nobody does. It must be called with c.mu held.
+func (c *CallCache) follow(network string, chainID uint64) *chainHead {
+	head, ok := c.heads[chainID]
+	if !ok {
+		head = &chainHead{}
+		c.heads[chainID] = head
+	}
+	head.lastRead = time.Now()
+	if !head.following {
+		head.following = true
+		go c.followHeads(network, chainID, head)
+	}
+	return head
+}
+
+// followHeads updates the head of the chain until it was not read for
+// headIdleTimeout. The head is forgotten while the network is unreachable,
+// so reads of the latest block are not cached meanwhile, and an idle chain
+// is not retried.
+func (c *CallCache) followHeads(network string, chainID uint64, head *chainHead) {
+	for {
+		err := c.watchHeads(network, head)
+		if err != nil {
+			log.Printf("Call cache: heads of chain %v: %v", chainID, err)
+		}
+
+		c.mu.Lock()
+		head.header, head.recent = nil, nil
+		head.generation++
+		if err == nil || time.Since(head.lastRead) > headIdleTimeout {
+			head.following = false
+			c.mu.Unlock()
+			return
+		}
+		c.mu.Unlock()
+
+		time.Sleep(headRetryDelay)
+	}
+}
+
+// watchHeads sets the head of the chain on every new one, from a
+// subscription or by polling, and returns nil once the chain is idle.
+func (c *CallCache) watchHeads(network string, head *chainHead) error {
+	client, err := c.dial(network)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	headers := make(chan *types.Header)
+	var errs <-chan error
+	if sub, err := client.SubscribeNewHead(ctx, headers); err == nil {
+		defer sub.Unsubscribe()
+		errs = sub.Err()
+	} else {
+		headers = nil
+	}
+
+	// The first head is polled either way, subscriptions only report
+	// new ones.
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+	for {
+		select {
+		case h := <-headers:
+			c.setHead(head, h)
+		case err := <-errs:
+			return err
+		case <-poll.C:
+			h, err := client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return err
+			}
+			c.setHead(head, h)
+			if headers == nil {
+				poll.Reset(headPollInterval)
+			}
+		case <-time.After(headPollInterval):
+		}
+
+		c.mu.Lock()
+		idle := time.Since(head.lastRead) > headIdleTimeout
+		c.mu.Unlock()
+		if idle {
+			return nil
+		}
+	}
+}
+
+// setHead makes h the head of the chain, dropping the reads of the
+// previous head if it changed.
+func (c *CallCache) setHead(head *chainHead, h *types.Header) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if head.header != nil && head.header.Hash() == h.Hash() {
+		return
+	}
+	head.header, head.recent = h, map[common.Hash][]byte{}
+	head.generation++
+}
+
+// callKey identifies a read of contract with data at block, the latest
+// if nil.
+func callKey(chainID uint64, contract common.Address, data []byte, block *big.Int) common.Hash {
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], chainID)
+	var number []byte
+	if block != nil {
+		number = common.LeftPadBytes(block.Bytes(), 32)
+	}
+	return crypto.Keccak256Hash(id[:], contract.Bytes(), number, crypto.Keccak256(data))
+}
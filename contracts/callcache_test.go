@@ -0,0 +1,185 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/mislavio/contracter/testchain"
+)
+
+// simulatedChainID is the chain ID of the simulated backend.
+const simulatedChainID = 1337
+
+// cacheChain returns a simulated chain with a box storing 42 and a client
+// reading it through a new cache in memory.
+func cacheChain(t *testing.T) (*testchain.Chain, *CallCache, *Client, common.Address) {
+	c := testchain.New(t, 8000000, 1, nil)
+	box, receipt := c.Deploy(0, 1000000, parsedBox.Bytecode)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("deploying the box failed")
+	}
+	storeValue(t, c, box, 42)
+
+	cache := NewCallCache(NewMemoryCallStore(10), CallStoreMemory, c.Dial)
+	return c, cache, cache.Client(c.RPC(), "simulated"), box
+}
+
+func storeValue(t *testing.T, c *testchain.Chain, box common.Address, value int64) {
+	if c.Send(0, 1000000, box, nil, mustPack(t, parsedBoxABI, "store", big.NewInt(value))).Status != types.ReceiptStatusSuccessful {
+		t.Fatal("store failed")
+	}
+}
+
+// retrieve reads the value of the box at block, the latest if nil.
+func retrieve(t *testing.T, client *Client, box common.Address, block *big.Int) int64 {
+	t.Helper()
+	out, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &box, Data: mustPack(t, parsedBoxABI, "retrieve")}, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return new(big.Int).SetBytes(out).Int64()
+}
+
+// waitHead waits until the cache follows the chain at block number.
+func waitHead(t *testing.T, cache *CallCache, number uint64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cache.mu.Lock()
+		head := cache.heads[simulatedChainID]
+		ok := head != nil && head.header != nil && head.header.Number.Uint64() == number
+		cache.mu.Unlock()
+		if ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the cache did not follow the chain to block %v", number)
+}
+
+// wantStats fails the test unless the cache has the hits and misses.
+func wantStats(t *testing.T, cache *CallCache, hits uint64, misses uint64) {
+	t.Helper()
+	if got := cache.Stats(); got.Hits != hits || got.Misses != misses || got.Backend != CallStoreMemory {
+		t.Errorf("Stats() = %+v, want %v hits and %v misses of %v", got, hits, misses, CallStoreMemory)
+	}
+}
+
+func TestCallCacheLatest(t *testing.T) {
+	c, cache, client, box := cacheChain(t)
+
+	// The first read follows the chain, latest reads are not cached until
+	// its head is known.
+	if got := retrieve(t, client, box, nil); got != 42 {
+		t.Errorf("retrieve() = %v, want 42", got)
+	}
+	waitHead(t, cache, 2)
+	wantStats(t, cache, 0, 1)
+
+	retrieve(t, client, box, nil)
+	if got := retrieve(t, client, box, nil); got != 42 {
+		t.Errorf("cached retrieve() = %v, want 42", got)
+	}
+	wantStats(t, cache, 1, 2)
+
+	// A new head drops the reads of the previous one.
+	storeValue(t, c, box, 43)
+	waitHead(t, cache, 3)
+	if got := retrieve(t, client, box, nil); got != 43 {
+		t.Errorf("retrieve() after a new head = %v, want 43", got)
+	}
+	wantStats(t, cache, 1, 3)
+	retrieve(t, client, box, nil)
+	wantStats(t, cache, 2, 3)
+}
+
+func TestCallCachePinned(t *testing.T) {
+	c, cache, client, box := cacheChain(t)
+	retrieve(t, client, box, nil)
+	waitHead(t, cache, 2)
+
+	// Reads of a recent block are kept until the next head.
+	recent := big.NewInt(2)
+	retrieve(t, client, box, recent)
+	retrieve(t, client, box, recent)
+	wantStats(t, cache, 1, 2)
+
+	storeValue(t, c, box, 43)
+	for i := 0; i < callFinality; i++ {
+		c.Commit()
+	}
+	waitHead(t, cache, 3+callFinality)
+
+	// Block 2 is final now, its reads are stored and still see 42.
+	if got := retrieve(t, client, box, recent); got != 42 {
+		t.Errorf("retrieve() at block 2 = %v, want 42", got)
+	}
+	wantStats(t, cache, 1, 3)
+	if got := retrieve(t, client, box, recent); got != 42 {
+		t.Errorf("stored retrieve() at block 2 = %v, want 42", got)
+	}
+	wantStats(t, cache, 2, 3)
+	if len(cache.store.(*MemoryCallStore).results) != 1 {
+		t.Errorf("store holds %v results, want the final read", len(cache.store.(*MemoryCallStore).results))
+	}
+
+	// A recent block is read at its own state.
+	if got := retrieve(t, client, box, big.NewInt(3)); got != 43 {
+		t.Errorf("retrieve() at block 3 = %v, want 43", got)
+	}
+}
+
+func TestCallCacheBypass(t *testing.T) {
+	_, cache, client, box := cacheChain(t)
+	retrieve(t, client, box, nil)
+	waitHead(t, cache, 2)
+
+	msg := ethereum.CallMsg{From: common.HexToAddress("0x00000000000000000000000000000000000000aa"), To: &box, Data: mustPack(t, parsedBoxABI, "retrieve")}
+	for i := 0; i < 2; i++ {
+		if _, err := client.CallContract(context.Background(), msg, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantStats(t, cache, 0, 1)
+
+	// Reverts are not cached.
+	msg.From = common.Address{}
+	msg.Data = mustPack(t, parsedBoxABI, "fail")
+	for i := 0; i < 2; i++ {
+		_, err := client.CallContract(context.Background(), msg, nil)
+		if reason, ok := RevertFromError(err, boxABI); !ok || reason.Message != "Box: failed" {
+			t.Errorf("fail() = %v, want Box: failed", err)
+		}
+	}
+	wantStats(t, cache, 0, 3)
+}
+
+func TestCallCacheIdleUnreachable(t *testing.T) {
+	dials := 0
+	cache := NewCallCache(NewMemoryCallStore(10), CallStoreMemory, func(string) (*ethclient.Client, error) {
+		dials++
+		return nil, errors.New("unreachable")
+	})
+	head := &chainHead{following: true, lastRead: time.Now().Add(-2 * headIdleTimeout)}
+
+	done := make(chan struct{})
+	go func() {
+		cache.followHeads("simulated", simulatedChainID, head)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("followHeads retries an idle chain")
+	}
+	if head.following || dials != 1 {
+		t.Errorf("following = %v after %v dials, want false after 1", head.following, dials)
+	}
+}
@@ -1,6 +1,10 @@
 package contracts
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
 	"github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/mislavio/contracter/accounts"
 	"github.com/mislavio/contracter/helpers"
@@ -9,9 +13,101 @@ import (
 // Contract represents a smart contract published to the contracter API.
 type Contract struct {
 	helpers.BaseModel
-	ABI      postgres.Jsonb `json:"abi"`
-	Bytecode []byte         `json:"bytecode"`
-	Address  string         `json:"address"`
+	Name                   string         `json:"name"`
+	Version                string         `json:"version"`
+	SourceName             string         `json:"sourceName"`
+	ABI                    postgres.Jsonb `json:"abi"`
+	Bytecode               []byte         `json:"bytecode"`
+	DeployedBytecode       []byte         `json:"deployedBytecode"`
+	LinkReferences         postgres.Jsonb `json:"linkReferences"`
+	DeployedLinkReferences postgres.Jsonb `json:"deployedLinkReferences"`
+	ImmutableReferences    postgres.Jsonb `json:"immutableReferences"`
+	CompilerVersion        string         `json:"compilerVersion"`
+	Metadata               postgres.Jsonb `json:"metadata"`
+	MetadataHashType       string         `json:"metadataHashType,omitempty"`
+	MetadataHash           string         `json:"metadataHash,omitempty"`
+	SolcVersion            string         `json:"solcVersion,omitempty"`
+	StorageLayout          postgres.Jsonb `json:"storageLayout"`
+	Analysis               postgres.Jsonb `json:"analysis"`
+	Network                string         `json:"network"`
+	Address                string         `json:"address"`
+}
+
+// NewContractFromArtifact returns an unsaved Contract for a compiled artifact.
+func NewContractFromArtifact(a *Artifact) (*Contract, error) {
+	linkRefs, err := marshalLinkReferences(a.LinkReferences)
+	if err != nil {
+		return nil, err
+	}
+	deployedLinkRefs, err := marshalLinkReferences(a.DeployedLinkReferences)
+	if err != nil {
+		return nil, err
+	}
+	var immutableRefs postgres.Jsonb
+	if len(a.ImmutableReferences) > 0 {
+		b, err := json.Marshal(a.ImmutableReferences)
+		if err != nil {
+			return nil, err
+		}
+		immutableRefs.RawMessage = b
+	}
+	if len(a.StorageLayout) > 0 {
+		if err := json.Unmarshal(a.StorageLayout, &StorageLayout{}); err != nil {
+			return nil, fmt.Errorf("%v: invalid storage layout: %v", a.Name, err)
+		}
+	}
+
+	c := &Contract{
+		Name:                   a.Name,
+		SourceName:             a.SourceName,
+		ABI:                    postgres.Jsonb{RawMessage: a.ABI},
+		Bytecode:               a.Bytecode,
+		DeployedBytecode:       a.DeployedBytecode,
+		LinkReferences:         linkRefs,
+		DeployedLinkReferences: deployedLinkRefs,
+		ImmutableReferences:    immutableRefs,
+		CompilerVersion:        a.CompilerVersion,
+		Metadata:               postgres.Jsonb{RawMessage: a.Metadata},
+		StorageLayout:          postgres.Jsonb{RawMessage: a.StorageLayout},
+	}
+	if m := c.setBytecodeMetadata(); m != nil && len(a.Metadata) > 0 {
+		if err := m.Check(a.Metadata); err != nil {
+			return nil, fmt.Errorf("%v: %v", a.Name, err)
+		}
+	}
+	if err := c.analyze(); err != nil {
+		return nil, fmt.Errorf("%v: %v", a.Name, err)
+	}
+	return c, nil
+}
+
+// setBytecodeMetadata stores the metadata hash and compiler version solc
+// embedded in the bytecode of c and returns the decoded metadata. Bytecode
+// without valid metadata, e.g. from other compilers, is left alone.
+func (c *Contract) setBytecodeMetadata() *BytecodeMetadata {
+	code := c.DeployedBytecode
+	if len(code) == 0 {
+		code = c.Bytecode
+	}
+	m, err := DecodeBytecodeMetadata(code)
+	if err != nil || m == nil {
+		return nil
+	}
+	c.MetadataHashType = m.HashType
+	c.MetadataHash = m.Hash
+	c.SolcVersion = m.SolcVersion
+	if c.CompilerVersion == "" {
+		c.CompilerVersion = m.SolcVersion
+	}
+	return m
+}
+
+func marshalLinkReferences(refs LinkReferences) (postgres.Jsonb, error) {
+	if len(refs) == 0 {
+		return postgres.Jsonb{}, nil
+	}
+	b, err := json.Marshal(refs)
+	return postgres.Jsonb{RawMessage: b}, err
 }
 
 // MyContract represents the mapping between an Account
@@ -23,3 +119,61 @@ type MyContract struct {
 	ContractID string
 	Contract   Contract
 }
+
+// FindOrFalse returns false if the Account is not linked to the Contract.
+func (m *MyContract) FindOrFalse(accountID string, contractID string, db *gorm.DB) bool {
+	return db.Where("account_id = ? AND contract_id = ?", accountID, contractID).Preload("Contract").Find(m).RecordNotFound()
+}
+
+// Deployment represents a deployment of a Contract to a network.
+type Deployment struct {
+	helpers.BaseModel
+	ContractID        string         `json:"contractId"`
+	Network           string         `json:"network"`
+	AccountID         string         `json:"accountId"`
+	Wallet            string         `json:"wallet"`
+	Address           string         `json:"address"`
+	TransactionHash   string         `json:"transactionHash"`
+	Nonce             uint64         `json:"nonce"`
+	GasUsed           uint64         `json:"gasUsed"`
+	EffectiveGasPrice string         `json:"effectiveGasPrice"`
+	BlockNumber       uint64         `json:"blockNumber"`
+	Status            string         `json:"status"`
+	ConstructorArgs   postgres.Jsonb `json:"constructorArgs"`
+	Libraries         postgres.Jsonb `json:"libraries"`
+	Factory           string         `json:"factory,omitempty"`
+	Salt              string         `json:"salt,omitempty"`
+}
+
+// FindByIDOrFalse returns false if record not found.
+func (d *Deployment) FindByIDOrFalse(id string, db *gorm.DB) bool {
+	return db.Where("id = ?", id).Find(d).RecordNotFound()
+}
+
+// Transaction represents a transaction sent by an Account through
+// the contracter API.
+type Transaction struct {
+	helpers.BaseModel
+	Hash         string         `json:"hash" gorm:"unique_index"`
+	AccountID    string         `json:"accountId"`
+	ContractID   string         `json:"contractId"`
+	Network      string         `json:"network"`
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	Nonce        uint64         `json:"nonce"`
+	Status       string         `json:"status"`
+	BlockNumber  uint64         `json:"blockNumber"`
+	RevertReason postgres.Jsonb `json:"revertReason"`
+}
+
+// Transaction statuses
+const (
+	TxPending = "pending"
+	TxSuccess = "success"
+	TxFailed  = "failed"
+)
+
+// FindByHashOrFalse returns false if record not found.
+func (t *Transaction) FindByHashOrFalse(hash string, accountID string, db *gorm.DB) bool {
+	return db.Where("hash = ? AND account_id = ?", hash, accountID).Find(t).RecordNotFound()
+}
@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DeterministicDeploymentProxy is the keyless CREATE2 factory deployed at the
+// same address on most networks, see https://github.com/Arachnid/deterministic-deployment-proxy.
+// It takes the salt followed by the init code as calldata.
+var DeterministicDeploymentProxy = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// ParseSalt parses a 0x prefixed hex salt of at most 32 bytes. Shorter
+// salts are left-padded with zeros.
+func ParseSalt(s string) ([32]byte, error) {
+	var salt [32]byte
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return salt, err
+	}
+	if len(b) > 32 {
+		return salt, errors.New("salt longer than 32 bytes")
+	}
+	copy(salt[32-len(b):], b)
+	return salt, nil
+}
+
+// Create2Address returns the address a CREATE2 factory deploys initCode to.
+func Create2Address(factory common.Address, salt [32]byte, initCode []byte) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+}
+
+// Create2Calldata returns the calldata for deploying initCode through the
+// deterministic deployment proxy.
+func Create2Calldata(salt [32]byte, initCode []byte) []byte {
+	return append(append([]byte{}, salt[:]...), initCode...)
+}
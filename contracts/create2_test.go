@@ -0,0 +1,67 @@
+package contracts
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCreate2Address(t *testing.T) {
+	// Examples from EIP-1014.
+	tests := []struct {
+		factory  string
+		salt     string
+		initCode string
+		want     string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "0x00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0xdeadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+		{"0x00000000000000000000000000000000deadbeef", "0xcafebabe", "0xdeadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, tt := range tests {
+		salt, err := ParseSalt(tt.salt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := Create2Address(common.HexToAddress(tt.factory), salt, common.FromHex(tt.initCode))
+		if got != common.HexToAddress(tt.want) {
+			t.Errorf("Create2Address(%v, %v, %v) = %v, want %v", tt.factory, tt.salt, tt.initCode, got.Hex(), tt.want)
+		}
+	}
+}
+
+func TestParseSalt(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{s: "0x01", want: "0x0000000000000000000000000000000000000000000000000000000000000001"},
+		{s: "0x" + "ab" + "00000000000000000000000000000000000000000000000000000000000000", want: "0xab00000000000000000000000000000000000000000000000000000000000000"},
+		{s: "0x" + "00000000000000000000000000000000000000000000000000000000000000000001", wantErr: true},
+		{s: "01", wantErr: true},
+		{s: "0x0g", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSalt(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSalt(%v) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if err == nil && common.Hash(got) != common.HexToHash(tt.want) {
+			t.Errorf("ParseSalt(%v) = %x, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestCreate2Calldata(t *testing.T) {
+	salt, _ := ParseSalt("0x01")
+	got := Create2Calldata(salt, []byte{0x60, 0x00})
+	if len(got) != 34 || got[31] != 1 || !bytes.Equal(got[32:], []byte{0x60, 0x00}) {
+		t.Errorf("Create2Calldata = %x", got)
+	}
+}
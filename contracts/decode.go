@@ -0,0 +1,238 @@
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/jinzhu/gorm"
+)
+
+// DecodedCall is calldata decoded into a method and its arguments.
+// Arguments without a name are keyed by position, e.g. _0. Source is abi
+// for calls decoded with a contract ABI and signature for calls decoded
+// with the signature database, whose arguments are unnamed.
+type DecodedCall struct {
+	Selector  string                 `json:"selector"`
+	Method    string                 `json:"method,omitempty"`
+	Signature string                 `json:"signature,omitempty"`
+	Args      map[string]interface{} `json:"args,omitempty"`
+	Source    string                 `json:"source,omitempty"`
+}
+
+// DecodedTransaction is a transaction with its receipt, decoded with the
+// ABIs of the contracts it touched.
+type DecodedTransaction struct {
+	Hash            string        `json:"hash"`
+	Network         string        `json:"network"`
+	Status          string        `json:"status"`
+	BlockNumber     uint64        `json:"blockNumber,omitempty"`
+	From            string        `json:"from"`
+	To              string        `json:"to,omitempty"`
+	ContractAddress string        `json:"contractAddress,omitempty"`
+	ContractID      string        `json:"contractId,omitempty"`
+	Value           string        `json:"value"`
+	Nonce           uint64        `json:"nonce"`
+	GasLimit        uint64        `json:"gasLimit"`
+	GasPrice        string        `json:"gasPrice"`
+	GasUsed         uint64        `json:"gasUsed,omitempty"`
+	Input           *DecodedCall  `json:"input,omitempty"`
+	Data            string        `json:"data"`
+	Events          []*Event      `json:"events"`
+	Revert          *RevertReason `json:"revertReason,omitempty"`
+}
+
+// DecodeCall decodes calldata with the methods of parsed. It returns nil
+// if the selector is not in the ABI or the arguments do not decode.
+func DecodeCall(parsed *abi.ABI, data []byte) *DecodedCall {
+	if parsed == nil || len(data) < 4 {
+		return nil
+	}
+	m, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil
+	}
+	values, err := m.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil
+	}
+	return &DecodedCall{
+		Selector:  hexutil.Encode(data[:4]),
+		Method:    m.RawName,
+		Signature: m.Sig(),
+		Args:      namedArgs(m.Inputs, values),
+		Source:    SignatureSourceABI,
+	}
+}
+
+// DecodeCallWithSignatures decodes calldata with the function signatures
+// known for its selector. A signature only matches if the arguments encode
+// back to the calldata, which rules out most colliding selectors.
+func DecodeCallWithSignatures(data []byte, db *gorm.DB) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, nil
+	}
+	sigs, err := LookupSignatures(hexutil.Encode(data[:4]), []string{SignatureFunction}, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sigs {
+		name, args, err := ParseSignature(s.Text)
+		if err != nil {
+			continue
+		}
+		values, err := args.UnpackValues(data[4:])
+		if err != nil {
+			continue
+		}
+		if packed, err := args.Pack(values...); err != nil || !bytes.Equal(packed, data[4:]) {
+			continue
+		}
+		return &DecodedCall{
+			Selector:  s.Selector,
+			Method:    name,
+			Signature: s.Text,
+			Args:      namedArgs(args, values),
+			Source:    "signature",
+		}, nil
+	}
+	return nil, nil
+}
+
+func namedArgs(inputs abi.Arguments, values []interface{}) map[string]interface{} {
+	args := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		name := inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("_%d", i)
+		}
+		args[name] = FormatValue(v)
+	}
+	return args
+}
+
+// ParseSignature parses a text signature such as
+// swap((address,uint256)[],bytes) into its name and unnamed arguments.
+func ParseSignature(sig string) (name string, args abi.Arguments, err error) {
+	// abi.NewType panics on some malformed types, signatures come from
+	// imported dumps.
+	defer func() {
+		if r := recover(); r != nil {
+			name, args, err = "", nil, fmt.Errorf("invalid signature %v: %v", sig, r)
+		}
+	}()
+
+	open := strings.Index(sig, "(")
+	if open <= 0 || !strings.HasSuffix(sig, ")") {
+		return "", nil, fmt.Errorf("invalid signature %v", sig)
+	}
+	types, err := splitTypeList(sig[open+1 : len(sig)-1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid signature %v: %v", sig, err)
+	}
+
+	args = make(abi.Arguments, len(types))
+	for i, t := range types {
+		m, err := typeMarshaling(t)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid signature %v: %v", sig, err)
+		}
+		typ, err := abi.NewType(m.Type, "", m.Components)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid signature %v: %v", sig, err)
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return sig[:open], args, nil
+}
+
+// typeMarshaling converts a canonical type, where tuples are written as
+// their component types in parentheses, to the form abi.NewType expects.
+func typeMarshaling(t string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(t, "(") {
+		return abi.ArgumentMarshaling{Type: t}, nil
+	}
+	end := strings.LastIndex(t, ")")
+	types, err := splitTypeList(t[1:end])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+
+	m := abi.ArgumentMarshaling{Type: "tuple" + t[end+1:]}
+	for i, c := range types {
+		component, err := typeMarshaling(c)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		// Components become struct fields, which need exported names.
+		component.Name = fmt.Sprintf("field%d", i)
+		m.Components = append(m.Components, component)
+	}
+	return m, nil
+}
+
+// splitTypeList splits a comma separated list of types at the top level.
+func splitTypeList(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var types []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				types = append(types, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	types = append(types, s[start:])
+	for _, t := range types {
+		if t == "" {
+			return nil, errors.New("empty type")
+		}
+	}
+	return types, nil
+}
+
+// KnownContract returns the contract linked to the account which is
+// deployed at address on network, found through its deployments or the
+// address it was imported or registered with. Proxies resolve to the
+// contract registered for the proxy, which carries the implementation ABI.
+func KnownContract(accountID string, network string, address common.Address, db *gorm.DB) (*Contract, error) {
+	c := &Contract{}
+	d := &Deployment{}
+	err := db.Where("account_id = ? AND network = ? AND address = ?", accountID, network, address.Hex()).
+		Order("created_at desc").First(d).Error
+	if err == nil {
+		m := &MyContract{}
+		if !m.FindOrFalse(accountID, d.ContractID, db) {
+			return &m.Contract, nil
+		}
+	} else if !gorm.IsRecordNotFoundError(err) {
+		return nil, err
+	}
+
+	err = db.Joins("JOIN my_contracts ON my_contracts.contract_id = contracts.id::text").
+		Where("my_contracts.account_id = ? AND contracts.network = ? AND contracts.address = ?", accountID, network, address.Hex()).
+		First(c).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
+	}
+	return c, err
+}
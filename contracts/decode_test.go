@@ -0,0 +1,42 @@
+package contracts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSignature(t *testing.T) {
+	tests := []struct {
+		sig       string
+		wantName  string
+		wantTypes []string
+		wantErr   bool
+	}{
+		{sig: "totalSupply()", wantName: "totalSupply"},
+		{sig: "transfer(address,uint256)", wantName: "transfer", wantTypes: []string{"address", "uint256"}},
+		{sig: "swap((address,uint256)[],bytes)", wantName: "swap", wantTypes: []string{"(address,uint256)[]", "bytes"}},
+		{sig: "f((uint8,(bool,string)),bytes32[2][])", wantName: "f", wantTypes: []string{"(uint8,(bool,string))", "bytes32[2][]"}},
+		{sig: "transfer", wantErr: true},
+		{sig: "(address)", wantErr: true},
+		{sig: "transfer(address", wantErr: true},
+		{sig: "f(address))", wantErr: true},
+		{sig: "f((address)", wantErr: true},
+		{sig: "f(foo)", wantErr: true},
+		{sig: "f(address,,uint256)", wantErr: true},
+		{sig: "f(string,mapping)", wantErr: true},
+	}
+	for _, tt := range tests {
+		name, args, err := ParseSignature(tt.sig)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSignature(%q) error = %v, wantErr %v", tt.sig, err, tt.wantErr)
+			continue
+		}
+		var types []string
+		for _, arg := range args {
+			types = append(types, arg.Type.String())
+		}
+		if name != tt.wantName || !reflect.DeepEqual(types, tt.wantTypes) {
+			t.Errorf("ParseSignature(%q) = %v, %v, want %v, %v", tt.sig, name, types, tt.wantName, tt.wantTypes)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package contracts
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Event is a log emitted by a contract. Logs which do not match an event
+// of the known ABI keep their raw topics and data.
+type Event struct {
+	Address   string                 `json:"address"`
+	Name      string                 `json:"name,omitempty"`
+	Signature string                 `json:"signature,omitempty"`
+	Args      map[string]interface{} `json:"args,omitempty"`
+	Topics    []string               `json:"topics,omitempty"`
+	Data      string                 `json:"data,omitempty"`
+}
+
+// DecodeLog decodes l with the events of parsed, which may be nil.
+// Indexed arguments of dynamic types are only available as their hash.
+func DecodeLog(parsed *abi.ABI, l *types.Log) *Event {
+	e := &Event{Address: l.Address.Hex()}
+
+	if parsed != nil && len(l.Topics) > 0 {
+		for _, ev := range parsed.Events {
+			if ev.Anonymous || ev.ID() != l.Topics[0] {
+				continue
+			}
+			if args, err := decodeEventArgs(ev, l); err == nil {
+				e.Name = ev.RawName
+				e.Signature = ev.Sig()
+				e.Args = args
+				return e
+			}
+		}
+	}
+
+	for _, t := range l.Topics {
+		e.Topics = append(e.Topics, t.Hex())
+	}
+	e.Data = hexutil.Encode(l.Data)
+	return e
+}
+
+func decodeEventArgs(ev abi.Event, l *types.Log) (map[string]interface{}, error) {
+	values, err := ev.Inputs.NonIndexed().UnpackValues(l.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make(map[string]interface{}, len(ev.Inputs))
+	topic := 1
+	for i, in := range ev.Inputs {
+		name := in.Name
+		if name == "" {
+			name = fmt.Sprintf("_%d", i)
+		}
+
+		if !in.Indexed {
+			args[name] = FormatValue(values[0])
+			values = values[1:]
+			continue
+		}
+
+		if topic >= len(l.Topics) {
+			return nil, fmt.Errorf("%v: missing topic for %v", ev.Sig(), name)
+		}
+		t := l.Topics[topic]
+		topic++
+		switch in.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			args[name] = t.Hex()
+		default:
+			v, err := abi.Arguments{{Type: in.Type}}.UnpackValues(t.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			args[name] = FormatValue(v[0])
+		}
+	}
+	return args, nil
+}
+
+// FormatValue prepares a decoded ABI value for JSON, encoding byte arrays
+// and slices as hex instead of lists of numbers.
+func FormatValue(v interface{}) interface{} {
+	if a, ok := v.(common.Address); ok {
+		return a.Hex()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = FormatValue(rv.Index(i).Interface())
+		}
+		return items
+	}
+	return v
+}
+
+// FormatValues applies FormatValue to each value.
+func FormatValues(values []interface{}) []interface{} {
+	formatted := make([]interface{}, len(values))
+	for i, v := range values {
+		formatted[i] = FormatValue(v)
+	}
+	return formatted
+}
@@ -0,0 +1,1840 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Dialer connects to a configured network by name.
+type Dialer func(network string) (*Client, error)
+
+// Request Response payloads.
+
+// ImportPayload represents a request body for importing a contract
+// deployed outside of Contracter.
+type ImportPayload struct {
+	Network          string          `json:"network"`
+	Address          string          `json:"address"`
+	ABI              json.RawMessage `json:"abi"`
+	DeployedBytecode string          `json:"deployedBytecode"`
+}
+
+// ContractResponse represents a contract response. Bytecode is returned
+// as 0x prefixed hex.
+type ContractResponse struct {
+	*Contract
+	Bytecode         string `json:"bytecode"`
+	DeployedBytecode string `json:"deployedBytecode"`
+}
+
+// DeployPayload represents a contract deployment request body.
+type DeployPayload struct {
+	ContractID string            `json:"contractId"`
+	Network    string            `json:"network"`
+	Args       []json.RawMessage `json:"args"`
+	// Libraries maps library names to an address or to the ID of a
+	// Contracter deployment of the library.
+	Libraries map[string]string `json:"libraries"`
+	// Salt deploys the contract through the CREATE2 factory when set.
+	Salt string `json:"salt"`
+}
+
+// CallPayload represents a contract function call in a request body.
+// Method is a function name or, for overloaded functions, a signature.
+type CallPayload struct {
+	Method string            `json:"method"`
+	Args   []json.RawMessage `json:"args"`
+}
+
+// ProxyPayload represents an upgradeable proxy deployment request body.
+// The contract is deployed as the implementation and Initializer is
+// called through the proxy when it is deployed.
+type ProxyPayload struct {
+	DeployPayload
+	Kind        string       `json:"kind"`
+	Initializer *CallPayload `json:"initializer"`
+}
+
+// UpgradePayload represents a proxy upgrade request body. The contract is
+// deployed to the network of the proxy and Initializer is called through
+// the proxy when it is upgraded.
+type UpgradePayload struct {
+	ContractID  string            `json:"contractId"`
+	Args        []json.RawMessage `json:"args"`
+	Libraries   map[string]string `json:"libraries"`
+	Initializer *CallPayload      `json:"initializer"`
+}
+
+// ChangeAdminPayload represents a request body handing a transparent proxy
+// over to another admin.
+type ChangeAdminPayload struct {
+	Admin string `json:"admin"`
+}
+
+// ProxyResponse represents a proxy response with the ABI of its current
+// implementation and its implementation history, newest first.
+type ProxyResponse struct {
+	*Proxy
+	ABI             postgres.Jsonb         `json:"abi"`
+	Implementations []*ProxyImplementation `json:"implementations"`
+}
+
+// LayoutReportResponse represents a storage layout comparison response.
+type LayoutReportResponse struct {
+	*LayoutReport
+}
+
+// ABIDiffResponse represents the ABI diff between two contract versions.
+type ABIDiffResponse struct {
+	BaseID        string `json:"baseId"`
+	BaseVersion   string `json:"baseVersion"`
+	TargetID      string `json:"targetId"`
+	TargetVersion string `json:"targetVersion"`
+	*ABIDiff
+}
+
+// PredictAddressResponse represents a CREATE2 address prediction response.
+type PredictAddressResponse struct {
+	Network      string `json:"network"`
+	Address      string `json:"address"`
+	Factory      string `json:"factory"`
+	Salt         string `json:"salt"`
+	InitCodeHash string `json:"initCodeHash"`
+}
+
+// DeploymentResponse represents a deployment response.
+type DeploymentResponse struct {
+	*Deployment
+}
+
+// VerifyPayload names the deployment to verify a contract against, either
+// by deployment ID or by network and address.
+type VerifyPayload struct {
+	DeploymentID string `json:"deploymentId"`
+	Network      string `json:"network"`
+	Address      string `json:"address"`
+}
+
+// VerificationResponse represents a verification response.
+type VerificationResponse struct {
+	*Verification
+}
+
+// TransactionResponse represents a transaction response.
+type TransactionResponse struct {
+	*Transaction
+}
+
+// SignatureLookupResponse lists the known signatures of a selector or
+// event topic.
+type SignatureLookupResponse struct {
+	Selector   string       `json:"selector"`
+	Signatures []*Signature `json:"signatures"`
+}
+
+// TokenTransferPayload represents a token transfer request body. From is
+// only used by transferFrom. Amount is in decimal units of the token.
+type TokenTransferPayload struct {
+	Network string `json:"network"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Amount  string `json:"amount"`
+}
+
+// TokenApprovePayload represents a token approval request body. Amount is
+// in decimal units of the token or max.
+type TokenApprovePayload struct {
+	Network string `json:"network"`
+	Spender string `json:"spender"`
+	Amount  string `json:"amount"`
+}
+
+// TokenMetadataResponse represents a token metadata response.
+type TokenMetadataResponse struct {
+	*TokenMetadata
+}
+
+// TokenBalanceResponse represents a token balance response.
+type TokenBalanceResponse struct {
+	Owner      string `json:"owner"`
+	Balance    string `json:"balance"`
+	BalanceRaw string `json:"balanceRaw"`
+}
+
+// TokenAllowanceResponse represents a token allowance response.
+type TokenAllowanceResponse struct {
+	Owner        string `json:"owner"`
+	Spender      string `json:"spender"`
+	Allowance    string `json:"allowance"`
+	AllowanceRaw string `json:"allowanceRaw"`
+}
+
+// TokenTransactionResponse represents a sent token transaction with the
+// amount in decimal and base units.
+type TokenTransactionResponse struct {
+	*Transaction
+	Amount    string `json:"amount"`
+	AmountRaw string `json:"amountRaw"`
+}
+
+// NFTTransferPayload represents an NFT transfer request body. From
+// defaults to the wallet. Amount is the number of ERC-1155 tokens and
+// defaults to 1, Data is passed to the receiver as 0x prefixed hex.
+type NFTTransferPayload struct {
+	Network string `json:"network"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	TokenID string `json:"tokenId"`
+	Amount  string `json:"amount"`
+	Data    string `json:"data"`
+}
+
+// NFTBatchTransferPayload represents a request body transferring several
+// tokens. Amounts are only used by ERC-1155 and default to 1 per token.
+type NFTBatchTransferPayload struct {
+	Network  string   `json:"network"`
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	TokenIDs []string `json:"tokenIds"`
+	Amounts  []string `json:"amounts"`
+	Data     string   `json:"data"`
+}
+
+// NFTApprovePayload represents an NFT approval request body. It either
+// approves an operator for all tokens of the wallet or, for ERC-721, a
+// spender for one token.
+type NFTApprovePayload struct {
+	Network  string `json:"network"`
+	Operator string `json:"operator"`
+	Approved *bool  `json:"approved"`
+	Spender  string `json:"spender"`
+	TokenID  string `json:"tokenId"`
+}
+
+// NFTMintPayload represents a mint request body. Method defaults to
+// safeMint or mint, whichever the ABI has.
+type NFTMintPayload struct {
+	Network string            `json:"network"`
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+}
+
+// NFTCollectionResponse represents an NFT collection response.
+type NFTCollectionResponse struct {
+	*NFTCollection
+}
+
+// NFTTokenResponse represents an NFT token response.
+type NFTTokenResponse struct {
+	*NFTToken
+}
+
+// NFTBalanceResponse represents an NFT balance response. TokenID is only
+// set for ERC-1155.
+type NFTBalanceResponse struct {
+	Owner   string `json:"owner"`
+	TokenID string `json:"tokenId,omitempty"`
+	Balance string `json:"balance"`
+}
+
+// NFTIndexResponse represents the state of an owner index.
+type NFTIndexResponse struct {
+	*NFTIndex
+	Complete bool `json:"complete"`
+}
+
+// NFTOwnersResponse represents the indexed owners of a collection.
+type NFTOwnersResponse struct {
+	Index  *NFTIndexResponse `json:"index"`
+	Owners []*NFTOwner       `json:"owners"`
+}
+
+// NFTTransactionsResponse represents the transactions sent for an NFT
+// request. ERC-721 batch transfers send one transaction per token.
+type NFTTransactionsResponse struct {
+	Transactions []*Transaction `json:"transactions"`
+}
+
+// TemplateDeployPayload represents a template deployment request body.
+// Parameters are validated against the schema of the template.
+type TemplateDeployPayload struct {
+	Network    string                     `json:"network"`
+	Parameters map[string]json.RawMessage `json:"parameters"`
+	// Salt deploys the template through the CREATE2 factory when set.
+	Salt string `json:"salt"`
+}
+
+// BatchCallPayload represents a batch of reads executed at one block,
+// the latest when Block is empty. Network defaults to the network the
+// contracts are deployed on.
+type BatchCallPayload struct {
+	Network string           `json:"network"`
+	Block   string           `json:"block"`
+	Calls   []*BatchCallItem `json:"calls"`
+}
+
+// BatchCallItem represents a read of a contract method. Address
+// overrides where the contract is deployed.
+type BatchCallItem struct {
+	ContractID string            `json:"contractId"`
+	Address    string            `json:"address"`
+	Method     string            `json:"method"`
+	Args       []json.RawMessage `json:"args"`
+}
+
+// CallBatchResponse represents the results of a batch of reads.
+type CallBatchResponse struct {
+	*CallBatch
+}
+
+// TemplateResponse represents a template with the JSON Schema of its
+// parameters. The ABI is only included for a single template.
+type TemplateResponse struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Standard    string                 `json:"standard,omitempty"`
+	Schema      map[string]interface{} `json:"schema"`
+	ABI         json.RawMessage        `json:"abi,omitempty"`
+}
+
+// Bind implements the binder interface.
+func (d *DeployPayload) Bind(r *http.Request) error {
+	if d.ContractID != "" {
+		if _, err := uuid.FromString(d.ContractID); err != nil {
+			return errors.New("invalid contract id")
+		}
+	}
+	for name, lib := range d.Libraries {
+		if common.IsHexAddress(lib) {
+			continue
+		}
+		if _, err := uuid.FromString(lib); err != nil {
+			return fmt.Errorf("library %v must be an address or a deployment id", name)
+		}
+	}
+	if d.Salt != "" {
+		if _, err := ParseSalt(d.Salt); err != nil {
+			return fmt.Errorf("invalid salt: %v", err)
+		}
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (p *ProxyPayload) Bind(r *http.Request) error {
+	if err := p.DeployPayload.Bind(r); err != nil {
+		return err
+	}
+	if p.ContractID == "" {
+		return errors.New("missing contract id")
+	}
+	if p.Salt != "" {
+		return errors.New("proxies can not be deployed with a salt")
+	}
+	if p.Kind == "" {
+		p.Kind = ProxyTransparent
+	}
+	if !ValidProxyKind(p.Kind) {
+		return fmt.Errorf("unknown proxy kind %v", p.Kind)
+	}
+	return p.Initializer.validate()
+}
+
+// Bind implements the binder interface.
+func (u *UpgradePayload) Bind(r *http.Request) error {
+	d := &DeployPayload{ContractID: u.ContractID, Libraries: u.Libraries}
+	if err := d.Bind(r); err != nil {
+		return err
+	}
+	if u.ContractID == "" {
+		return errors.New("missing contract id")
+	}
+	return u.Initializer.validate()
+}
+
+// Bind implements the binder interface.
+func (c *ChangeAdminPayload) Bind(r *http.Request) error {
+	if !common.IsHexAddress(c.Admin) || common.HexToAddress(c.Admin) == (common.Address{}) {
+		return errors.New("invalid admin address")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (t *TokenTransferPayload) Bind(r *http.Request) error {
+	if t.From != "" && !common.IsHexAddress(t.From) {
+		return errors.New("invalid from address")
+	}
+	if !common.IsHexAddress(t.To) {
+		return errors.New("invalid to address")
+	}
+	if t.Amount == "" {
+		return errors.New("missing amount")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (t *TokenApprovePayload) Bind(r *http.Request) error {
+	if !common.IsHexAddress(t.Spender) {
+		return errors.New("invalid spender address")
+	}
+	if t.Amount == "" {
+		return errors.New("missing amount")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (n *NFTTransferPayload) Bind(r *http.Request) error {
+	if n.From != "" && !common.IsHexAddress(n.From) {
+		return errors.New("invalid from address")
+	}
+	if !common.IsHexAddress(n.To) {
+		return errors.New("invalid to address")
+	}
+	if _, err := ParseTokenID(n.TokenID); err != nil {
+		return err
+	}
+	if n.Amount == "" {
+		n.Amount = "1"
+	}
+	if _, err := ParseTokenID(n.Amount); err != nil {
+		return fmt.Errorf("invalid amount %q", n.Amount)
+	}
+	if _, err := DecodeData(n.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (n *NFTBatchTransferPayload) Bind(r *http.Request) error {
+	if n.From != "" && !common.IsHexAddress(n.From) {
+		return errors.New("invalid from address")
+	}
+	if !common.IsHexAddress(n.To) {
+		return errors.New("invalid to address")
+	}
+	if len(n.TokenIDs) == 0 {
+		return errors.New("missing token ids")
+	}
+	for _, id := range n.TokenIDs {
+		if _, err := ParseTokenID(id); err != nil {
+			return err
+		}
+	}
+	if len(n.Amounts) == 0 {
+		n.Amounts = make([]string, len(n.TokenIDs))
+		for i := range n.Amounts {
+			n.Amounts[i] = "1"
+		}
+	}
+	if len(n.Amounts) != len(n.TokenIDs) {
+		return fmt.Errorf("got %d amounts for %d token ids", len(n.Amounts), len(n.TokenIDs))
+	}
+	for _, a := range n.Amounts {
+		if _, err := ParseTokenID(a); err != nil {
+			return fmt.Errorf("invalid amount %q", a)
+		}
+	}
+	if _, err := DecodeData(n.Data); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (n *NFTApprovePayload) Bind(r *http.Request) error {
+	switch {
+	case n.Operator != "" && n.Spender != "":
+		return errors.New("approve either an operator or a spender")
+	case n.Operator != "":
+		if !common.IsHexAddress(n.Operator) {
+			return errors.New("invalid operator address")
+		}
+		if n.Approved == nil {
+			return errors.New("missing approved")
+		}
+	case n.Spender != "":
+		if !common.IsHexAddress(n.Spender) {
+			return errors.New("invalid spender address")
+		}
+		if _, err := ParseTokenID(n.TokenID); err != nil {
+			return err
+		}
+	default:
+		return errors.New("missing operator or spender")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (n *NFTMintPayload) Bind(r *http.Request) error {
+	return nil
+}
+
+// Bind implements the binder interface.
+func (b *BatchCallPayload) Bind(r *http.Request) error {
+	if len(b.Calls) == 0 {
+		return errors.New("missing calls")
+	}
+	if len(b.Calls) > MaxBatchCalls {
+		return fmt.Errorf("more than %d calls", MaxBatchCalls)
+	}
+	if _, err := ParseBlockNumber(b.Block); err != nil {
+		return err
+	}
+	for i, c := range b.Calls {
+		if c == nil {
+			return fmt.Errorf("call %d: missing", i)
+		}
+		if _, err := uuid.FromString(c.ContractID); err != nil {
+			return fmt.Errorf("call %d: invalid contract id", i)
+		}
+		if c.Address != "" && !common.IsHexAddress(c.Address) {
+			return fmt.Errorf("call %d: invalid address %v", i, c.Address)
+		}
+		if c.Method == "" {
+			return fmt.Errorf("call %d: missing method", i)
+		}
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (t *TemplateDeployPayload) Bind(r *http.Request) error {
+	if t.Salt != "" {
+		if _, err := ParseSalt(t.Salt); err != nil {
+			return fmt.Errorf("invalid salt: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeData decodes optional 0x prefixed hex data.
+func DecodeData(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %v", err)
+	}
+	return b, nil
+}
+
+func (c *CallPayload) validate() error {
+	if c != nil && c.Method == "" {
+		return errors.New("missing initializer method")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (v *VerifyPayload) Bind(r *http.Request) error {
+	if v.DeploymentID != "" {
+		if _, err := uuid.FromString(v.DeploymentID); err != nil {
+			return errors.New("invalid deployment id")
+		}
+		return nil
+	}
+	if (v.Network == "") != (v.Address == "") {
+		return errors.New("network and address must be given together")
+	}
+	if v.Address != "" && !common.IsHexAddress(v.Address) {
+		return errors.New("invalid address")
+	}
+	return nil
+}
+
+// Bind implements the binder interface.
+func (i *ImportPayload) Bind(r *http.Request) error {
+	if i.Network == "" {
+		return errors.New("missing network")
+	}
+	if !common.IsHexAddress(i.Address) {
+		return errors.New("invalid address")
+	}
+	if len(i.ABI) == 0 {
+		return errors.New("missing abi")
+	}
+	// Accept the ABI both as a JSON array and as a JSON encoded string.
+	var s string
+	if err := json.Unmarshal(i.ABI, &s); err == nil {
+		i.ABI = json.RawMessage(s)
+	}
+	if i.DeployedBytecode != "" {
+		if _, err := hexutil.Decode(i.DeployedBytecode); err != nil {
+			return errors.New("invalid deployed bytecode")
+		}
+	}
+	return nil
+}
+
+// Render implements the renderer interface. The status is left to the
+// handler as new deployments are returned with 201.
+func (d *DeploymentResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface. The status is left to the
+// handler as upgrades are returned with 202.
+func (p *ProxyResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (d *ABIDiffResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (l *LayoutReportResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+// Render implements the renderer interface. The status is left to the
+// handler as new verifications are returned with 201.
+func (v *VerificationResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (c *ContractResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	c.Bytecode = hexutil.Encode(c.Contract.Bytecode)
+	c.DeployedBytecode = hexutil.Encode(c.Contract.DeployedBytecode)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (p *PredictAddressResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (t *TransactionResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (s *SignatureLookupResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (t *TokenMetadataResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (t *TokenBalanceResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (t *TokenAllowanceResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface. Token transactions are
+// returned as soon as they are sent.
+func (t *TokenTransactionResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, http.StatusAccepted)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NFTCollectionResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NFTTokenResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NFTBalanceResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NFTIndexResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NFTOwnersResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface. NFT transactions are returned
+// as soon as they are sent.
+func (n *NFTTransactionsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, http.StatusAccepted)
+	return nil
+}
+
+// Render implements the renderer interface.
+func (c *CallBatchResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (s *CallCacheStats) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (s *StorageValue) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (s *AccountState) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (t *TemplateResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (d *DecodedTransaction) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (s *SignatureImport) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Request Handlers
+
+// GetTransaction returns a transaction sent by the current account.
+func GetTransaction(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		hash := chi.URLParam(r, "hash")
+
+		t := &Transaction{}
+		if t.FindByHashOrFalse(hash, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("transaction", hash))
+			return
+		}
+
+		render.Render(w, r, &TransactionResponse{t})
+	})
+}
+
+// ListDeployments returns all deployments of a contract linked to the current account.
+func ListDeployments(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		m := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+
+		var deployments []*Deployment
+		if err := db.Where("contract_id = ? AND account_id = ?", id, a.ID.String()).Order("created_at desc").Find(&deployments).Error; err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		list := []render.Renderer{}
+		for _, d := range deployments {
+			list = append(list, &DeploymentResponse{d})
+		}
+		render.RenderList(w, r, list)
+	})
+}
+
+// GetDeployment returns a deployment of a contract linked to the current account.
+func GetDeployment(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		d := &Deployment{}
+		if _, err := uuid.FromString(id); err != nil || d.FindByIDOrFalse(id, db) || d.AccountID != a.ID.String() {
+			render.Render(w, r, helpers.ErrNotFound("deployment", id))
+			return
+		}
+
+		render.Render(w, r, &DeploymentResponse{d})
+	})
+}
+
+// VerifyContract compares the runtime code of a deployment of a contract
+// linked to the current account with its stored deployed bytecode and
+// records the result. Without a request body the contract's own address
+// or its latest successful deployment is verified.
+func VerifyContract(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		m := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+		c := &m.Contract
+		if len(c.DeployedBytecode) == 0 {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("contract has no deployed bytecode")))
+			return
+		}
+
+		data := &VerifyPayload{}
+		if r.ContentLength != 0 {
+			if err := render.Bind(r, data); err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+		}
+
+		v, libs, err := verificationTarget(a.ID.String(), c, data, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		expected, wildcards, err := c.ExpectedRuntimeCode(libs)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		client, err := dial(v.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		code, err := client.CodeAt(r.Context(), common.HexToAddress(v.Address), nil)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		v.AccountID = a.ID.String()
+		v.Status, v.Message = CompareRuntimeCode(expected, code, wildcards)
+		v.VerifiedAt = time.Now().UTC()
+		if err := db.Create(v).Error; err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.Render(w, r, &VerificationResponse{v})
+	})
+}
+
+// verificationTarget returns an unsaved verification of c for the requested
+// deployment by the account and the library addresses the deployment was
+// linked with.
+func verificationTarget(accountID string, c *Contract, data *VerifyPayload, db *gorm.DB) (*Verification, map[string]common.Address, error) {
+	v := &Verification{ContractID: c.ID.String()}
+	libs := map[string]common.Address{}
+
+	d := &Deployment{}
+	switch {
+	case data.DeploymentID != "":
+		if d.FindByIDOrFalse(data.DeploymentID, db) || d.ContractID != c.ID.String() || d.AccountID != accountID {
+			return nil, nil, helpers.ErrNotFound("deployment", data.DeploymentID)
+		}
+	case data.Address != "":
+		v.Network, v.Address = data.Network, common.HexToAddress(data.Address).Hex()
+		// Use the library addresses if the address is a known deployment.
+		if db.Where("contract_id = ? AND account_id = ? AND network = ? AND address = ?", c.ID.String(), accountID, v.Network, v.Address).
+			Order("created_at desc").First(d).RecordNotFound() {
+			return v, libs, nil
+		}
+	case c.Address != "":
+		v.Network, v.Address = c.Network, c.Address
+		return v, libs, nil
+	default:
+		if db.Where("contract_id = ? AND account_id = ? AND status = ?", c.ID.String(), accountID, TxSuccess).
+			Order("created_at desc").First(d).RecordNotFound() {
+			return nil, nil, helpers.ErrBadRequest(errors.New("contract has no successful deployment, pass a network and address"))
+		}
+	}
+
+	v.DeploymentID = d.ID.String()
+	v.Network, v.Address = d.Network, d.Address
+	if raw := d.Libraries.RawMessage; len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &libs); err != nil {
+			return nil, nil, helpers.ErrInternal(err)
+		}
+	}
+	return v, libs, nil
+}
+
+// ListVerifications returns the verifications of a contract linked to the
+// current account, newest first.
+func ListVerifications(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		m := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+
+		verifications, err := Verifications(id, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		list := []render.Renderer{}
+		for _, v := range verifications {
+			list = append(list, &VerificationResponse{v})
+		}
+		render.RenderList(w, r, list)
+	})
+}
+
+// NewProxyResponse loads the ABI and implementation history of p.
+func NewProxyResponse(p *Proxy, db *gorm.DB) (*ProxyResponse, error) {
+	c := &Contract{}
+	if err := db.Where("id = ?", p.ContractID).First(c).Error; err != nil {
+		return nil, err
+	}
+
+	resp := &ProxyResponse{Proxy: p, ABI: c.ABI}
+	if err := db.Where("proxy_id = ?", p.ID.String()).Order("created_at desc").Find(&resp.Implementations).Error; err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetProxy returns a proxy deployed by the current account.
+func GetProxy(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &Proxy{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("proxy", id))
+			return
+		}
+
+		resp, err := NewProxyResponse(p, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Render(w, r, resp)
+	})
+}
+
+// CheckUpgrade compares the storage layout of the current implementation
+// of a proxy with the layout of the contract it would be upgraded to.
+func CheckUpgrade(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &Proxy{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("proxy", id))
+			return
+		}
+
+		data := &UpgradePayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		m := &MyContract{}
+		if m.FindOrFalse(a.ID.String(), data.ContractID, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", data.ContractID))
+			return
+		}
+
+		report, err := p.CompareLayout(&m.Contract, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if report == nil {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("both implementations must be registered with a storage layout")))
+			return
+		}
+
+		render.Render(w, r, &LayoutReportResponse{report})
+	})
+}
+
+// ImportContract registers a contract deployed outside of Contracter and
+// links it to the current account.
+func ImportContract(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &ImportPayload{}
+
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		if _, err := abi.JSON(bytes.NewReader(data.ABI)); err != nil {
+			render.Render(w, r, helpers.ErrABIInvalid(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+		address := common.HexToAddress(data.Address)
+
+		if !db.Joins("JOIN my_contracts ON my_contracts.contract_id = contracts.id::text").
+			Where("my_contracts.account_id = ? AND contracts.network = ? AND contracts.address = ?", a.ID.String(), data.Network, address.Hex()).
+			First(&Contract{}).RecordNotFound() {
+			render.Render(w, r, helpers.ErrConflict(errors.New("contract already imported")))
+			return
+		}
+
+		client, err := dial(data.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		code, err := client.CodeAt(r.Context(), address, nil)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		if len(code) == 0 {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("no contract code at %v on %v", address.Hex(), data.Network)))
+			return
+		}
+
+		if data.DeployedBytecode != "" && !bytes.Equal(code, common.FromHex(data.DeployedBytecode)) {
+			render.Render(w, r, helpers.ErrConflict(errors.New("runtime bytecode does not match the supplied bytecode")))
+			return
+		}
+
+		c := &Contract{
+			ABI:              postgres.Jsonb{RawMessage: data.ABI},
+			DeployedBytecode: code,
+			Network:          data.Network,
+			Address:          address.Hex(),
+		}
+		c.setBytecodeMetadata()
+		if err := c.analyze(); err != nil {
+			render.Render(w, r, helpers.ErrABIInvalid(err))
+			return
+		}
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(c).Error; err != nil {
+				return err
+			}
+			return tx.Create(&MyContract{AccountID: a.ID.String(), ContractID: c.ID.String()}).Error
+		}); err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.Render(w, r, &ContractResponse{Contract: c})
+	})
+}
+
+// CreateContracts registers every contract of a Hardhat or Truffle artifact
+// or of solc --standard-json output and links them to the current account.
+func CreateContracts(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		artifacts, err := ParseArtifacts(body)
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+		if len(artifacts) == 0 {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("no contracts found")))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		// Every contract of the upload is published with the same version.
+		var version Version
+		versioned := r.URL.Query().Get("version") != ""
+		if versioned {
+			if version, err = ParseVersion(r.URL.Query().Get("version")); err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+		}
+		allowBreaking := r.URL.Query().Get("allowBreaking") == "true"
+
+		var created []*Contract
+		for _, artifact := range artifacts {
+			if _, err := abi.JSON(bytes.NewReader(artifact.ABI)); err != nil {
+				render.Render(w, r, helpers.ErrABIInvalid(fmt.Errorf("%v: %v", artifact.Name, err)))
+				return
+			}
+			c, err := NewContractFromArtifact(artifact)
+			if err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+			if versioned {
+				c.Version = version.String()
+				if err := CheckNewVersion(a.ID.String(), c, version, allowBreaking, db); err != nil {
+					render.Render(w, r, helpers.ErrUpstream(err))
+					return
+				}
+			}
+			created = append(created, c)
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			for _, c := range created {
+				if err := tx.Create(c).Error; err != nil {
+					return err
+				}
+				if err := tx.Create(&MyContract{AccountID: a.ID.String(), ContractID: c.ID.String()}).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		list := []render.Renderer{}
+		for _, c := range created {
+			list = append(list, &ContractResponse{Contract: c})
+		}
+		render.Status(r, http.StatusCreated)
+		render.RenderList(w, r, list)
+	})
+}
+
+// ListVersions returns the versions of a contract family linked to the
+// current account, oldest first.
+func ListVersions(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		name := chi.URLParam(r, "name")
+
+		versions, err := FamilyVersions(a.ID.String(), name, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if len(versions) == 0 {
+			render.Render(w, r, helpers.ErrNotFound("contract family", name))
+			return
+		}
+
+		list := []render.Renderer{}
+		for _, c := range versions {
+			list = append(list, &ContractResponse{Contract: c})
+		}
+		render.RenderList(w, r, list)
+	})
+}
+
+// DiffContract returns the ABI diff between a contract and the contract
+// given by the base query parameter, which defaults to the previous
+// version of its family.
+func DiffContract(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		target := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || target.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+
+		var base *Contract
+		if baseID := r.URL.Query().Get("base"); baseID != "" {
+			m := &MyContract{}
+			if _, err := uuid.FromString(baseID); err != nil || m.FindOrFalse(a.ID.String(), baseID, db) {
+				render.Render(w, r, helpers.ErrNotFound("contract", baseID))
+				return
+			}
+			base = &m.Contract
+		} else {
+			var err error
+			if base, err = previousVersion(a.ID.String(), &target.Contract, db); err != nil {
+				render.Render(w, r, helpers.ErrInternal(err))
+				return
+			}
+			if base == nil {
+				render.Render(w, r, helpers.ErrBadRequest(errors.New("contract has no previous version, pass a base contract")))
+				return
+			}
+		}
+
+		diff, err := DiffABI(string(base.ABI.RawMessage), string(target.Contract.ABI.RawMessage))
+		if err != nil {
+			render.Render(w, r, helpers.ErrABIInvalid(err))
+			return
+		}
+
+		render.Render(w, r, &ABIDiffResponse{
+			BaseID:        base.ID.String(),
+			BaseVersion:   base.Version,
+			TargetID:      target.Contract.ID.String(),
+			TargetVersion: target.Contract.Version,
+			ABIDiff:       diff,
+		})
+	})
+}
+
+// maxSignatureDumpSize bounds the size of imported signature dumps.
+const maxSignatureDumpSize = 64 << 20
+
+// LookupSelector returns the function and error signatures known for a
+// four byte selector.
+func LookupSelector(db *gorm.DB) http.HandlerFunc {
+	return lookupSignatures(db, "selector", "selector", 4, []string{SignatureFunction, SignatureError})
+}
+
+// LookupTopic returns the event signatures known for a topic.
+func LookupTopic(db *gorm.DB) http.HandlerFunc {
+	return lookupSignatures(db, "topic", "hash", common.HashLength, []string{SignatureEvent})
+}
+
+// lookupSignatures returns the signatures of kinds for the selector of
+// length bytes in the URL parameter param.
+func lookupSignatures(db *gorm.DB, resource string, param string, length int, kinds []string) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		selector := chi.URLParam(r, param)
+
+		b, err := hexutil.Decode(selector)
+		if err != nil || len(b) != length {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("%v must be %d bytes of 0x prefixed hex", resource, length)))
+			return
+		}
+
+		sigs, err := LookupSignatures(hexutil.Encode(b), kinds, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if len(sigs) == 0 {
+			render.Render(w, r, helpers.ErrNotFound(resource, selector))
+			return
+		}
+
+		render.Render(w, r, &SignatureLookupResponse{Selector: hexutil.Encode(b), Signatures: sigs})
+	})
+}
+
+// ImportSignatureDump adds the signatures of a 4byte style text dump to
+// the signature database. The kind of the signatures is given as ?kind=,
+// functions by default.
+func ImportSignatureDump(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind := r.URL.Query().Get("kind")
+		switch kind {
+		case "":
+			kind = SignatureFunction
+		case SignatureFunction, SignatureEvent, SignatureError:
+		default:
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("unknown signature kind %v", kind)))
+			return
+		}
+
+		res, err := ImportSignatures(http.MaxBytesReader(w, r.Body, maxSignatureDumpSize), kind, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.Render(w, r, res)
+	})
+}
+
+// GetToken returns the metadata and total supply of an ERC-20 contract
+// linked to the current account.
+func GetToken(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t, client, err := dialToken(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		md, err := t.Metadata(r.Context(), client)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, &TokenMetadataResponse{md})
+	})
+}
+
+// GetTokenBalance returns the token balance of an address.
+func GetTokenBalance(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner := chi.URLParam(r, "address")
+		if !common.IsHexAddress(owner) {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid address %v", owner)))
+			return
+		}
+
+		t, client, err := dialToken(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		decimals, err := t.Decimals(r.Context(), client)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		balance, err := t.BalanceOf(r.Context(), client, common.HexToAddress(owner))
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		render.Render(w, r, &TokenBalanceResponse{
+			Owner:      common.HexToAddress(owner).Hex(),
+			Balance:    FormatUnits(balance, decimals),
+			BalanceRaw: balance.String(),
+		})
+	})
+}
+
+// GetTokenAllowance returns how much a spender may transfer on behalf of
+// an owner.
+func GetTokenAllowance(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner, spender := chi.URLParam(r, "owner"), chi.URLParam(r, "spender")
+		if !common.IsHexAddress(owner) || !common.IsHexAddress(spender) {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("invalid owner or spender address")))
+			return
+		}
+
+		t, client, err := dialToken(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		decimals, err := t.Decimals(r.Context(), client)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		allowance, err := t.Allowance(r.Context(), client, common.HexToAddress(owner), common.HexToAddress(spender))
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		render.Render(w, r, &TokenAllowanceResponse{
+			Owner:        common.HexToAddress(owner).Hex(),
+			Spender:      common.HexToAddress(spender).Hex(),
+			Allowance:    FormatUnits(allowance, decimals),
+			AllowanceRaw: allowance.String(),
+		})
+	})
+}
+
+// dialToken loads the token of the request, on the network given as
+// ?network= if any, and connects to its network.
+func dialToken(r *http.Request, db *gorm.DB, dial Dialer) (*Token, *Client, error) {
+	a, _ := auth.AccountFromContext(r.Context())
+
+	t, err := LoadToken(a.ID.String(), chi.URLParam(r, "id"), r.URL.Query().Get("network"), db)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := dial(t.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, client, nil
+}
+
+// nftIndexBudget is how long a request indexes owners before returning.
+// Indexing continues where it stopped on the next request.
+const nftIndexBudget = 20 * time.Second
+
+// GetNFT returns the standard, supported interfaces and, for ERC-721
+// collections with metadata, the name and symbol of an NFT contract.
+func GetNFT(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, client, err := dialNFT(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		c, err := n.Collection(r.Context(), client)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, &NFTCollectionResponse{c})
+	})
+}
+
+// GetNFTToken returns the owner and metadata URI of a token.
+func GetNFTToken(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := ParseTokenID(chi.URLParam(r, "tokenId"))
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		n, client, err := dialNFT(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		t, err := n.Token(r.Context(), client, id)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, &NFTTokenResponse{t})
+	})
+}
+
+// GetNFTBalance returns how many tokens an address holds, of the token
+// given as ?tokenId= for ERC-1155.
+func GetNFTBalance(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner := chi.URLParam(r, "address")
+		if !common.IsHexAddress(owner) {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid address %v", owner)))
+			return
+		}
+		var id *big.Int
+		if s := r.URL.Query().Get("tokenId"); s != "" {
+			var err error
+			if id, err = ParseTokenID(s); err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+		}
+
+		n, client, err := dialNFT(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		balance, err := n.BalanceOf(r.Context(), client, common.HexToAddress(owner), id)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		res := &NFTBalanceResponse{Owner: common.HexToAddress(owner).Hex(), Balance: balance.String()}
+		if n.Standard == StandardERC1155 {
+			res.TokenID = id.String()
+		}
+		render.Render(w, r, res)
+	})
+}
+
+// IndexNFTOwners indexes the Transfer events of an ERC-721 collection
+// since the last run. The first run starts at ?fromBlock= or the block
+// the contract was deployed in.
+func IndexNFTOwners(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var fromBlock *uint64
+		if s := r.URL.Query().Get("fromBlock"); s != "" {
+			b, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid fromBlock %v", s)))
+				return
+			}
+			fromBlock = &b
+		}
+
+		n, client, err := dialNFT(r, db, dial)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		idx, err := n.IndexOwners(r.Context(), client, fromBlock, time.Now().Add(nftIndexBudget), db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, &NFTIndexResponse{idx, idx.Complete()})
+	})
+}
+
+// ListNFTOwners returns the indexed owners of an ERC-721 collection,
+// only the tokens of the address given as ?owner= if any.
+func ListNFTOwners(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		owner := r.URL.Query().Get("owner")
+		if owner != "" && !common.IsHexAddress(owner) {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid owner %v", owner)))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+		m := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+		network, address, err := m.Contract.deployedAddress(a.ID.String(), r.URL.Query().Get("network"), db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		idx := &NFTIndex{}
+		if db.Where("network = ? AND address = ?", network, address.Hex()).First(idx).RecordNotFound() {
+			render.Render(w, r, helpers.ErrNotFound("owner index", id))
+			return
+		}
+		owners, err := NFTOwners(network, address, owner, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Render(w, r, &NFTOwnersResponse{
+			Index:  &NFTIndexResponse{idx, idx.Complete()},
+			Owners: owners,
+		})
+	})
+}
+
+// dialNFT loads the NFT collection of the request, on the network given
+// as ?network= if any, and detects its standard.
+func dialNFT(r *http.Request, db *gorm.DB, dial Dialer) (*NFT, *Client, error) {
+	a, _ := auth.AccountFromContext(r.Context())
+	return LoadNFT(r.Context(), a.ID.String(), chi.URLParam(r, "id"), r.URL.Query().Get("network"), dial, db)
+}
+
+// ListTemplates returns the catalog of contract templates.
+func ListTemplates() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list := []render.Renderer{}
+		for _, t := range Templates() {
+			list = append(list, &TemplateResponse{Name: t.Name, Description: t.Description, Standard: t.Standard, Schema: t.Schema()})
+		}
+		render.RenderList(w, r, list)
+	})
+}
+
+// GetTemplate returns a contract template with its ABI.
+func GetTemplate() http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		t, ok := FindTemplate(name)
+		if !ok {
+			render.Render(w, r, helpers.ErrNotFound("template", name))
+			return
+		}
+		render.Render(w, r, &TemplateResponse{Name: t.Name, Description: t.Description, Standard: t.Standard, Schema: t.Schema(), ABI: t.ABI()})
+	})
+}
+
+// BatchCalls executes many reads of contracts linked to the account at one
+// block, through Multicall3 where the network has it. Calls which cannot
+// be made, e.g. of unknown contracts or with invalid arguments, fail on
+// their own without failing the batch.
+func BatchCalls(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &BatchCallPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		batch := &CallBatch{Network: data.Network, Results: make([]*BatchCallResult, len(data.Calls))}
+		var calls []*BatchCall
+		var made []*BatchCallResult
+		contracts := map[string]*MyContract{}
+		for i, item := range data.Calls {
+			res := &BatchCallResult{ContractID: item.ContractID, Method: item.Method}
+			batch.Results[i] = res
+
+			m, ok := contracts[item.ContractID]
+			if !ok {
+				m = &MyContract{}
+				if m.FindOrFalse(a.ID.String(), item.ContractID, db) {
+					m = nil
+				}
+				contracts[item.ContractID] = m
+			}
+			if m == nil {
+				res.Error = "contract not found"
+				continue
+			}
+
+			network, address := batch.Network, common.HexToAddress(item.Address)
+			if item.Address == "" {
+				var err error
+				if network, address, err = m.Contract.deployedAddress(a.ID.String(), batch.Network, db); err != nil {
+					res.Error = helpers.ErrUpstream(err).Detail
+					continue
+				}
+			}
+			if batch.Network == "" {
+				batch.Network = network
+			}
+			if network != batch.Network {
+				render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("calls are deployed on %v and %v, set the network", batch.Network, network)))
+				return
+			}
+			res.Address = address.Hex()
+
+			parsed, err := abi.JSON(bytes.NewReader(m.Contract.ABI.RawMessage))
+			if err != nil {
+				res.Error = err.Error()
+				continue
+			}
+			method, err := FindMethod(parsed, item.Method)
+			if err != nil {
+				res.Error = err.Error()
+				continue
+			}
+			calldata, err := PackCall(parsed, item.Method, item.Args)
+			if err != nil {
+				res.Error = err.Error()
+				continue
+			}
+			calls = append(calls, &BatchCall{Target: address, Method: method, ABIJSON: string(m.Contract.ABI.RawMessage), Data: calldata})
+			made = append(made, res)
+		}
+		if batch.Network == "" {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("missing network, no call resolves to a deployment")))
+			return
+		}
+
+		block, _ := ParseBlockNumber(data.Block)
+		if err := executeCallBatch(r.Context(), dial, batch, calls, made, block); err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, &CallBatchResponse{batch})
+	})
+}
+
+// executeCallBatch executes calls on the network of batch, storing their
+// outcome in results and the block and whether Multicall3 was used in
+// batch. Every call is pinned to the same block, the head when the batch
+// started unless block is given.
+func executeCallBatch(ctx context.Context, dial Dialer, batch *CallBatch, calls []*BatchCall, results []*BatchCallResult, block *big.Int) error {
+	client, err := dial(batch.Network)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if block, err = PinBlock(ctx, client, block); err != nil {
+		return err
+	}
+	batch.BlockNumber = block.Uint64()
+
+	batch.Multicall, err = ExecuteBatch(ctx, client, calls, results, block)
+	return err
+}
+
+// GetCallCacheStats returns how many contract reads were answered from
+// the call cache.
+func GetCallCacheStats(cache *CallCache) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		render.Render(w, r, cache.Stats())
+	})
+}
+
+// GetStorage reads a storage slot of the contract's own address or its
+// latest successful deployment, at the block given as ?block= or the
+// latest. Each ?key= applies a mapping key, so the value of a mapping at
+// slot 1 is read with /storage/1?key=0x... Keys are encoded for the types
+// given as one ?keyType= per key, or else for the key types of the mapping
+// at slot in the contract's storage layout.
+func GetStorage(db *gorm.DB, dial Dialer) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slot, err := ParseSlot(chi.URLParam(r, "slot"))
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+		keys, keyTypes := r.URL.Query()["key"], r.URL.Query()["keyType"]
+		if len(keyTypes) > 0 && len(keyTypes) != len(keys) {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("%d keys but %d keyTypes", len(keys), len(keyTypes))))
+			return
+		}
+		block, err := ParseBlockNumber(r.URL.Query().Get("block"))
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+		m := &MyContract{}
+		if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(a.ID.String(), id, db) {
+			render.Render(w, r, helpers.ErrNotFound("contract", id))
+			return
+		}
+		if len(keyTypes) == 0 && len(keys) > 0 {
+			layout, err := m.Contract.ParsedStorageLayout()
+			if err != nil {
+				render.Render(w, r, helpers.ErrInternal(err))
+				return
+			}
+			if layout != nil {
+				keyTypes = layout.MappingKeyTypes(slot, len(keys))
+			}
+		}
+		parsedKeys := make([][]byte, len(keys))
+		for i, k := range keys {
+			keyType := ""
+			if keyTypes != nil {
+				keyType = keyTypes[i]
+			}
+			if parsedKeys[i], err = ParseStorageKey(k, keyType); err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+		}
+
+		network, address, err := m.Contract.deployedAddress(a.ID.String(), r.URL.Query().Get("network"), db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		client, err := dial(network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		if block, err = PinBlock(r.Context(), client, block); err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		storageSlot := MappingSlot(slot, parsedKeys...)
+		value, err := ReadStorage(r.Context(), client, address, storageSlot, block)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		v := &StorageValue{
+			ContractID:  id,
+			Network:     network,
+			Address:     address.Hex(),
+			BlockNumber: block.Uint64(),
+			Slot:        slot.Hex(),
+			StorageSlot: storageSlot.Hex(),
+			Value:       value.Hex(),
+		}
+		for _, k := range parsedKeys {
+			v.Keys = append(v.Keys, hexutil.Encode(k))
+		}
+		v.KeyTypes = keyTypes
+		render.Render(w, r, v)
+	})
+}
+
+// GetAccountState returns the balance, nonce and code hash of an address
+// on the network given as ?network=, or defaultNetwork, at the block given
+// as ?block= or the latest.
+func GetAccountState(dial Dialer, defaultNetwork string) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr := chi.URLParam(r, "addr")
+		if !common.IsHexAddress(addr) {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid address %v", addr)))
+			return
+		}
+		block, err := ParseBlockNumber(r.URL.Query().Get("block"))
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+		network := r.URL.Query().Get("network")
+		if network == "" {
+			network = defaultNetwork
+		}
+
+		client, err := dial(network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		if block, err = PinBlock(r.Context(), client, block); err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		state, err := ReadAccountState(r.Context(), client, common.HexToAddress(addr), block)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		state.Network = network
+		render.Render(w, r, state)
+	})
+}
@@ -0,0 +1,159 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/helpers"
+	"github.com/mislavio/contracter/testchain"
+	"github.com/mislavio/contracter/testdb"
+)
+
+// testAccount is the account making the requests of handler tests.
+var testAccount = &accounts.Account{BaseModel: helpers.BaseModel{ID: uuid.FromStringOrNil("3f0c7a0e-5c43-4e8b-9d2a-4a1c2b6f7e10")}}
+
+// serve serves a request with body made by testAccount.
+func serve(h http.Handler, method string, target string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), auth.AccountCtxKey, testAccount)))
+	return w
+}
+
+// errorDetail returns the detail of an error response.
+func errorDetail(w *httptest.ResponseRecorder) string {
+	var res struct {
+		Detail string `json:"detail"`
+	}
+	json.NewDecoder(w.Body).Decode(&res)
+	return res.Detail
+}
+
+func TestImportContract(t *testing.T) {
+	c := testchain.New(t, 8000000, 1, nil)
+	box, receipt := c.Deploy(0, 1000000, parsedBox.Bytecode)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("deploying the box failed")
+	}
+	// The box has immutables, so its runtime code is read from the chain.
+	code, err := c.CodeAt(context.Background(), box, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	dials := 0
+	dial := func(network string) (*Client, error) {
+		dials++
+		return NewCallCache(NewMemoryCallStore(10), CallStoreMemory, c.Dial).Client(c.RPC(), network), nil
+	}
+	abiJSON, _ := json.Marshal(boxABI)
+
+	tests := []struct {
+		name       string
+		address    common.Address
+		bytecode   []byte
+		imported   bool
+		wantStatus int
+		wantDetail string
+		wantDial   bool
+	}{
+		{
+			name:       "imported",
+			address:    box,
+			bytecode:   code,
+			wantStatus: http.StatusCreated,
+			wantDial:   true,
+		},
+		{
+			name:       "already imported",
+			address:    box,
+			imported:   true,
+			wantStatus: http.StatusConflict,
+			wantDetail: "contract already imported",
+		},
+		{
+			name:       "no code",
+			address:    empty,
+			wantStatus: http.StatusBadRequest,
+			wantDetail: fmt.Sprintf("no contract code at %v on simulated", empty.Hex()),
+			wantDial:   true,
+		},
+		{
+			name:       "bytecode mismatch",
+			address:    box,
+			bytecode:   parsedThrower.DeployedBytecode,
+			wantStatus: http.StatusConflict,
+			wantDetail: "runtime bytecode does not match the supplied bytecode",
+			wantDial:   true,
+		},
+	}
+	for _, tt := range tests {
+		db, mock := testdb.New(t)
+		rows := sqlmock.NewRows([]string{"id", "network", "address"})
+		if tt.imported {
+			rows.AddRow(uuid.NewV4().String(), "simulated", tt.address.Hex())
+		}
+		mock.ExpectQuery(`SELECT "contracts".* FROM "contracts" JOIN my_contracts .* WHERE .*my_contracts.account_id = \$1 AND contracts.network = \$2 AND contracts.address = \$3`).
+			WithArgs(testAccount.ID.String(), "simulated", tt.address.Hex()).
+			WillReturnRows(rows)
+		if tt.wantStatus == http.StatusCreated {
+			mock.ExpectBegin()
+			mock.ExpectQuery(`INSERT INTO "contracts" .* RETURNING`).
+				WithArgs(testdb.Args(22, map[int]interface{}{21: "simulated", 22: box.Hex()})...).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewV4().String()))
+			mock.ExpectExec(`INSERT INTO signatures .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 10))
+			mock.ExpectQuery(`INSERT INTO "my_contracts" .* RETURNING`).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewV4().String()))
+			mock.ExpectCommit()
+		}
+
+		body := fmt.Sprintf(`{"network":"simulated","address":%q,"abi":%s`, tt.address.Hex(), abiJSON)
+		if tt.bytecode != nil {
+			body += fmt.Sprintf(`,"deployedBytecode":%q`, hexutil.Encode(tt.bytecode))
+		}
+		dials = 0
+		w := serve(ImportContract(db, dial), http.MethodPost, "/contracts/import", body+"}")
+
+		if w.Code != tt.wantStatus {
+			t.Errorf("%v: ImportContract() = %v %v, want %v", tt.name, w.Code, w.Body, tt.wantStatus)
+			continue
+		}
+		if tt.wantDetail != "" {
+			if got := errorDetail(w); got != tt.wantDetail {
+				t.Errorf("%v: ImportContract() detail = %q, want %q", tt.name, got, tt.wantDetail)
+			}
+		}
+		if (dials > 0) != tt.wantDial {
+			t.Errorf("%v: ImportContract() dialed %v times, want dialing %v", tt.name, dials, tt.wantDial)
+		}
+		if tt.wantStatus != http.StatusCreated {
+			continue
+		}
+		var res struct {
+			Address          string        `json:"address"`
+			Network          string        `json:"network"`
+			DeployedBytecode hexutil.Bytes `json:"deployedBytecode"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if res.Address != box.Hex() || res.Network != "simulated" || !bytes.Equal(res.DeployedBytecode, code) {
+			t.Errorf("%v: ImportContract() = %v on %v with %x, want %v on simulated with the box code", tt.name, res.Address, res.Network, res.DeployedBytecode, box.Hex())
+		}
+	}
+}
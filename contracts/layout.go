@@ -0,0 +1,244 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Storage layout change kinds
+const (
+	LayoutRemoved  = "removed"
+	LayoutMoved    = "moved"
+	LayoutRetyped  = "retyped"
+	LayoutRenamed  = "renamed"
+	LayoutAdded    = "added"
+	LayoutOverlaps = "overlaps"
+)
+
+// StorageLayout is the storageLayout output of solc.
+type StorageLayout struct {
+	Storage []StorageVariable      `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable or struct member in a storage layout.
+type StorageVariable struct {
+	Label    string `json:"label"`
+	Contract string `json:"contract,omitempty"`
+	Slot     string `json:"slot"`
+	Offset   int    `json:"offset"`
+	Type     string `json:"type"`
+}
+
+// StorageType describes a type referenced by a storage layout.
+type StorageType struct {
+	Encoding      string            `json:"encoding"`
+	Label         string            `json:"label"`
+	NumberOfBytes string            `json:"numberOfBytes"`
+	Members       []StorageVariable `json:"members,omitempty"`
+	Key           string            `json:"key,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Base          string            `json:"base,omitempty"`
+}
+
+// LayoutChange is a difference between two storage layouts.
+type LayoutChange struct {
+	Kind       string `json:"kind"`
+	Label      string `json:"label"`
+	Slot       string `json:"slot"`
+	Offset     int    `json:"offset"`
+	OldType    string `json:"oldType,omitempty"`
+	NewType    string `json:"newType,omitempty"`
+	Compatible bool   `json:"compatible"`
+	Message    string `json:"message"`
+}
+
+// LayoutReport is the result of comparing the storage layout of an
+// implementation with the layout of its replacement.
+type LayoutReport struct {
+	Compatible bool            `json:"compatible"`
+	Changes    []*LayoutChange `json:"changes"`
+}
+
+// String returns the incompatible changes of the report.
+func (r *LayoutReport) String() string {
+	var lines []string
+	for _, c := range r.Changes {
+		if !c.Compatible {
+			lines = append(lines, c.Message)
+		}
+	}
+	if len(lines) == 0 {
+		return "storage layouts are compatible"
+	}
+	return strings.Join(lines, "; ")
+}
+
+// ParsedStorageLayout returns the storage layout of c, or nil if none was
+// uploaded with it.
+func (c *Contract) ParsedStorageLayout() (*StorageLayout, error) {
+	raw := c.StorageLayout.RawMessage
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	l := &StorageLayout{}
+	if err := json.Unmarshal(raw, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// CompareStorageLayouts reports how the storage of next differs from prev.
+// Variables must keep their slot, offset and type. Renames and variables
+// appended to free storage are compatible, everything else is not.
+func CompareStorageLayouts(prev *StorageLayout, next *StorageLayout) *LayoutReport {
+	report := &LayoutReport{Compatible: true, Changes: []*LayoutChange{}}
+	add := func(c *LayoutChange) {
+		report.Changes = append(report.Changes, c)
+		if !c.Compatible {
+			report.Compatible = false
+		}
+	}
+
+	nextAt := map[string]StorageVariable{}
+	nextByLabel := map[string]StorageVariable{}
+	for _, v := range next.Storage {
+		nextAt[position(v)] = v
+		nextByLabel[v.Label] = v
+	}
+	// Positions of next holding a variable of prev, whatever its name, and
+	// labels of moved variables are not reported again as added.
+	paired := map[string]bool{}
+	moved := map[string]bool{}
+
+	for _, old := range prev.Storage {
+		oldType := prev.canonicalType(old.Type)
+		c := &LayoutChange{Label: old.Label, Slot: old.Slot, Offset: old.Offset, OldType: oldType}
+
+		v, ok := nextAt[position(old)]
+		if m, found := nextByLabel[old.Label]; found && position(m) != position(old) && (!ok || v.Label != old.Label) {
+			c.Kind = LayoutMoved
+			c.NewType = next.canonicalType(m.Type)
+			c.Message = fmt.Sprintf("%v moved from slot %v offset %d to slot %v offset %d", old.Label, old.Slot, old.Offset, m.Slot, m.Offset)
+			moved[old.Label] = true
+			add(c)
+			continue
+		}
+		if !ok {
+			c.Kind = LayoutRemoved
+			c.Message = fmt.Sprintf("%v (%v) at slot %v offset %d was removed", old.Label, oldType, old.Slot, old.Offset)
+			add(c)
+			continue
+		}
+		paired[position(v)] = true
+
+		c.NewType = next.canonicalType(v.Type)
+		if c.NewType != oldType {
+			c.Kind = LayoutRetyped
+			c.Message = fmt.Sprintf("%v at slot %v offset %d changed type from %v to %v", old.Label, old.Slot, old.Offset, oldType, c.NewType)
+			if v.Label != old.Label {
+				c.Message = fmt.Sprintf("%v (%v) at slot %v offset %d was replaced by %v (%v)", old.Label, oldType, old.Slot, old.Offset, v.Label, c.NewType)
+			}
+			add(c)
+			continue
+		}
+		if v.Label != old.Label {
+			c.Kind = LayoutRenamed
+			c.Compatible = true
+			c.Message = fmt.Sprintf("%v at slot %v offset %d was renamed to %v", old.Label, old.Slot, old.Offset, v.Label)
+			add(c)
+		}
+	}
+
+	for _, v := range next.Storage {
+		if paired[position(v)] || moved[v.Label] {
+			continue
+		}
+		c := &LayoutChange{Kind: LayoutAdded, Label: v.Label, Slot: v.Slot, Offset: v.Offset, NewType: next.canonicalType(v.Type), Compatible: true}
+		c.Message = fmt.Sprintf("%v (%v) was added at slot %v offset %d", v.Label, c.NewType, v.Slot, v.Offset)
+		for _, old := range prev.Storage {
+			if prev.overlaps(old, next, v) {
+				c.Kind = LayoutOverlaps
+				c.Compatible = false
+				c.Message = fmt.Sprintf("%v (%v) at slot %v offset %d overlaps %v", v.Label, c.NewType, v.Slot, v.Offset, old.Label)
+				break
+			}
+		}
+		add(c)
+	}
+
+	sort.SliceStable(report.Changes, func(i, j int) bool {
+		return slotLess(report.Changes[i].Slot, report.Changes[j].Slot)
+	})
+	return report
+}
+
+func position(v StorageVariable) string {
+	return v.Slot + ":" + strconv.Itoa(v.Offset)
+}
+
+// canonicalType renders a type without the AST IDs solc puts into type
+// identifiers, so layouts of different compilations can be compared.
+func (l *StorageLayout) canonicalType(id string) string {
+	t, ok := l.Types[id]
+	if !ok {
+		return id
+	}
+
+	switch {
+	case t.Encoding == "mapping":
+		return fmt.Sprintf("mapping(%v => %v)", l.canonicalType(t.Key), l.canonicalType(t.Value))
+	case t.Encoding == "dynamic_array":
+		return l.canonicalType(t.Base) + "[]"
+	case t.Base != "":
+		// Static arrays carry their length only in the label.
+		if i := strings.LastIndex(t.Label, "["); i >= 0 {
+			return l.canonicalType(t.Base) + t.Label[i:]
+		}
+		return t.Label
+	case len(t.Members) > 0:
+		members := make([]string, len(t.Members))
+		for i, m := range t.Members {
+			members[i] = fmt.Sprintf("%v %v@%v:%d", l.canonicalType(m.Type), m.Label, m.Slot, m.Offset)
+		}
+		return "struct{" + strings.Join(members, "; ") + "}"
+	}
+	return t.Label
+}
+
+// overlaps reports whether variable a of l shares storage bytes with
+// variable b of other.
+func (l *StorageLayout) overlaps(a StorageVariable, other *StorageLayout, b StorageVariable) bool {
+	aStart, aEnd := l.byteRange(a)
+	bStart, bEnd := other.byteRange(b)
+	return aStart.Cmp(bEnd) < 0 && bStart.Cmp(aEnd) < 0
+}
+
+// byteRange returns the half-open range of storage bytes v occupies.
+func (l *StorageLayout) byteRange(v StorageVariable) (*big.Int, *big.Int) {
+	start, ok := new(big.Int).SetString(v.Slot, 10)
+	if !ok {
+		start = new(big.Int)
+	}
+	start.Mul(start, big.NewInt(32))
+	start.Add(start, big.NewInt(int64(v.Offset)))
+
+	size, ok := new(big.Int).SetString(l.Types[v.Type].NumberOfBytes, 10)
+	if !ok || size.Sign() == 0 {
+		size = big.NewInt(32)
+	}
+	return start, new(big.Int).Add(start, size)
+}
+
+func slotLess(a string, b string) bool {
+	x, okX := new(big.Int).SetString(a, 10)
+	y, okY := new(big.Int).SetString(b, 10)
+	if !okX || !okY {
+		return a < b
+	}
+	return x.Cmp(y) < 0
+}
@@ -0,0 +1,120 @@
+package contracts
+
+import (
+	"reflect"
+	"testing"
+)
+
+// layoutTypes are the types of the test layouts, with the AST IDs solc
+// puts into identifiers differing between compilations.
+var layoutTypes = map[string]StorageType{
+	"t_address":                      {Encoding: "inplace", Label: "address", NumberOfBytes: "20"},
+	"t_bool":                         {Encoding: "inplace", Label: "bool", NumberOfBytes: "1"},
+	"t_uint256":                      {Encoding: "inplace", Label: "uint256", NumberOfBytes: "32"},
+	"t_uint128":                      {Encoding: "inplace", Label: "uint128", NumberOfBytes: "16"},
+	"t_mapping(t_address,t_uint256)": {Encoding: "mapping", Label: "mapping(address => uint256)", NumberOfBytes: "32", Key: "t_address", Value: "t_uint256"},
+	"t_struct(Point)12_storage":      {Encoding: "inplace", Label: "struct Point", NumberOfBytes: "64", Members: []StorageVariable{{Label: "x", Slot: "0", Type: "t_uint256"}, {Label: "y", Slot: "1", Type: "t_uint256"}}},
+	"t_struct(Point)40_storage":      {Encoding: "inplace", Label: "struct Point", NumberOfBytes: "64", Members: []StorageVariable{{Label: "x", Slot: "0", Type: "t_uint256"}, {Label: "y", Slot: "1", Type: "t_uint256"}}},
+	"t_array(t_uint256)dyn_storage":  {Encoding: "dynamic_array", Label: "uint256[]", NumberOfBytes: "32", Base: "t_uint256"},
+	"t_array(t_struct(Point)40_storage)3_storage": {Encoding: "inplace", Label: "struct Point[3]", NumberOfBytes: "192", Base: "t_struct(Point)40_storage"},
+}
+
+func layout(vars ...StorageVariable) *StorageLayout {
+	return &StorageLayout{Storage: vars, Types: layoutTypes}
+}
+
+func TestCompareStorageLayouts(t *testing.T) {
+	owner := StorageVariable{Label: "owner", Slot: "0", Offset: 0, Type: "t_address"}
+	paused := StorageVariable{Label: "paused", Slot: "0", Offset: 20, Type: "t_bool"}
+	balances := StorageVariable{Label: "balances", Slot: "1", Type: "t_mapping(t_address,t_uint256)"}
+	origin := StorageVariable{Label: "origin", Slot: "2", Type: "t_struct(Point)12_storage"}
+	prev := layout(owner, paused, balances, origin)
+
+	tests := []struct {
+		name           string
+		next           *StorageLayout
+		wantCompatible bool
+		wantKinds      []string
+	}{
+		{
+			name:           "identical with other AST IDs",
+			next:           layout(owner, paused, balances, StorageVariable{Label: "origin", Slot: "2", Type: "t_struct(Point)40_storage"}),
+			wantCompatible: true,
+			wantKinds:      []string{},
+		},
+		{
+			name:           "appended",
+			next:           layout(owner, paused, balances, origin, StorageVariable{Label: "total", Slot: "4", Type: "t_uint256"}),
+			wantCompatible: true,
+			wantKinds:      []string{LayoutAdded},
+		},
+		{
+			name:           "packed into free bytes",
+			next:           layout(owner, paused, StorageVariable{Label: "locked", Slot: "0", Offset: 21, Type: "t_bool"}, balances, origin),
+			wantCompatible: true,
+			wantKinds:      []string{LayoutAdded},
+		},
+		{
+			name:           "renamed",
+			next:           layout(StorageVariable{Label: "admin", Slot: "0", Type: "t_address"}, paused, balances, origin),
+			wantCompatible: true,
+			wantKinds:      []string{LayoutRenamed},
+		},
+		{
+			name:           "retyped",
+			next:           layout(owner, paused, balances, StorageVariable{Label: "origin", Slot: "2", Type: "t_array(t_struct(Point)40_storage)3_storage"}),
+			wantCompatible: false,
+			wantKinds:      []string{LayoutRetyped},
+		},
+		{
+			name:           "removed",
+			next:           layout(owner, paused, origin),
+			wantCompatible: false,
+			wantKinds:      []string{LayoutRemoved},
+		},
+		{
+			name:           "overlapping",
+			next:           layout(owner, StorageVariable{Label: "paused", Slot: "0", Offset: 20, Type: "t_bool"}, StorageVariable{Label: "half", Slot: "0", Offset: 16, Type: "t_uint128"}, balances, origin),
+			wantCompatible: false,
+			wantKinds:      []string{LayoutOverlaps},
+		},
+		{
+			name:           "inserted before",
+			next:           layout(owner, paused, StorageVariable{Label: "fee", Slot: "1", Type: "t_uint256"}, StorageVariable{Label: "balances", Slot: "2", Type: "t_mapping(t_address,t_uint256)"}, StorageVariable{Label: "origin", Slot: "3", Type: "t_struct(Point)12_storage"}),
+			wantCompatible: false,
+			wantKinds:      []string{LayoutMoved, LayoutOverlaps, LayoutMoved},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := CompareStorageLayouts(prev, tt.next)
+			if report.Compatible != tt.wantCompatible {
+				t.Errorf("Compatible = %v, want %v: %v", report.Compatible, tt.wantCompatible, report)
+			}
+			kinds := []string{}
+			for _, c := range report.Changes {
+				kinds = append(kinds, c.Kind)
+			}
+			if !reflect.DeepEqual(kinds, tt.wantKinds) {
+				t.Errorf("kinds = %v, want %v", kinds, tt.wantKinds)
+			}
+		})
+	}
+}
+
+func TestCanonicalType(t *testing.T) {
+	l := layout()
+	tests := map[string]string{
+		"t_address":                                   "address",
+		"t_mapping(t_address,t_uint256)":              "mapping(address => uint256)",
+		"t_array(t_uint256)dyn_storage":               "uint256[]",
+		"t_struct(Point)12_storage":                   "struct{uint256 x@0:0; uint256 y@1:0}",
+		"t_array(t_struct(Point)40_storage)3_storage": "struct{uint256 x@0:0; uint256 y@1:0}[3]",
+		"t_unknown":                                   "t_unknown",
+	}
+	for id, want := range tests {
+		if got := l.canonicalType(id); got != want {
+			t.Errorf("canonicalType(%v) = %v, want %v", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package contracts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UnresolvedLibrariesError is returned when bytecode references libraries
+// which were not given an address.
+type UnresolvedLibrariesError struct {
+	Libraries []string
+}
+
+func (e *UnresolvedLibrariesError) Error() string {
+	return "unresolved libraries: " + strings.Join(e.Libraries, ", ")
+}
+
+// ParsedLinkReferences returns the creation bytecode link references of c.
+func (c *Contract) ParsedLinkReferences() (LinkReferences, error) {
+	return unmarshalLinkReferences(c.LinkReferences.RawMessage)
+}
+
+// ParsedDeployedLinkReferences returns the runtime bytecode link references of c.
+func (c *Contract) ParsedDeployedLinkReferences() (LinkReferences, error) {
+	return unmarshalLinkReferences(c.DeployedLinkReferences.RawMessage)
+}
+
+func unmarshalLinkReferences(raw json.RawMessage) (LinkReferences, error) {
+	refs := LinkReferences{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return refs, nil
+	}
+	err := json.Unmarshal(raw, &refs)
+	return refs, err
+}
+
+// Link returns a copy of bytecode with every placeholder in refs replaced
+// by the library address from libs. Libraries may be keyed by their fully
+// qualified name (source:Library) or by their name alone. Placeholders
+// which only carry the hash of the fully qualified name are matched
+// against the hashed keys of libs.
+func Link(bytecode []byte, refs LinkReferences, libs map[string]common.Address) ([]byte, error) {
+	linked := make([]byte, len(bytecode))
+	copy(linked, bytecode)
+
+	var unresolved []string
+	for source, names := range refs {
+		for name, positions := range names {
+			address, ok := resolveLibrary(source, name, libs)
+			if !ok {
+				unresolved = append(unresolved, qualifiedName(source, name))
+				continue
+			}
+			for _, p := range positions {
+				if p.Length != common.AddressLength || p.Start < 0 || p.Start+p.Length > len(linked) {
+					return nil, fmt.Errorf("invalid link reference for %v at %d", qualifiedName(source, name), p.Start)
+				}
+				copy(linked[p.Start:p.Start+p.Length], address.Bytes())
+			}
+		}
+	}
+
+	if len(unresolved) > 0 {
+		sort.Strings(unresolved)
+		return nil, &UnresolvedLibrariesError{Libraries: unresolved}
+	}
+	return linked, nil
+}
+
+func resolveLibrary(source string, name string, libs map[string]common.Address) (common.Address, bool) {
+	if a, ok := libs[qualifiedName(source, name)]; ok {
+		return a, true
+	}
+	if a, ok := libs[name]; ok {
+		return a, true
+	}
+	// solc >= 0.5 placeholders are __$<34 hex chars of keccak256(fqn)>$__
+	for key, a := range libs {
+		if libraryPlaceholderHash(key) == name {
+			return a, true
+		}
+	}
+	return common.Address{}, false
+}
+
+func libraryPlaceholderHash(fullyQualifiedName string) string {
+	return hex.EncodeToString(crypto.Keccak256([]byte(fullyQualifiedName)))[:34]
+}
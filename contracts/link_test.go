@@ -0,0 +1,97 @@
+package contracts
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestLink(t *testing.T) {
+	math := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	strs := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	// PUSH20 <Math> PUSH20 <Strings> PUSH20 <Math>
+	code := make([]byte, 63)
+	code[0], code[21], code[42] = 0x73, 0x73, 0x73
+	refs := LinkReferences{"contracts/Lib.sol": {
+		"Math":    {{Start: 1, Length: 20}, {Start: 43, Length: 20}},
+		"Strings": {{Start: 22, Length: 20}},
+	}}
+	linked := func(a, b common.Address) []byte {
+		out := append([]byte{}, code...)
+		copy(out[1:], a.Bytes())
+		copy(out[22:], b.Bytes())
+		copy(out[43:], a.Bytes())
+		return out
+	}
+
+	tests := []struct {
+		name           string
+		refs           LinkReferences
+		libs           map[string]common.Address
+		want           []byte
+		wantUnresolved []string
+		wantErr        bool
+	}{
+		{
+			name: "qualified names",
+			refs: refs,
+			libs: map[string]common.Address{"contracts/Lib.sol:Math": math, "contracts/Lib.sol:Strings": strs},
+			want: linked(math, strs),
+		},
+		{
+			name: "plain names",
+			refs: refs,
+			libs: map[string]common.Address{"Math": math, "Strings": strs},
+			want: linked(math, strs),
+		},
+		{
+			name: "placeholder hashes",
+			refs: LinkReferences{"": {
+				libraryPlaceholderHash("contracts/Lib.sol:Math"):    {{Start: 1, Length: 20}, {Start: 43, Length: 20}},
+				libraryPlaceholderHash("contracts/Lib.sol:Strings"): {{Start: 22, Length: 20}},
+			}},
+			libs: map[string]common.Address{"contracts/Lib.sol:Math": math, "contracts/Lib.sol:Strings": strs},
+			want: linked(math, strs),
+		},
+		{
+			name:           "unresolved",
+			refs:           refs,
+			libs:           map[string]common.Address{"Math": math},
+			wantUnresolved: []string{"contracts/Lib.sol:Strings"},
+			wantErr:        true,
+		},
+		{
+			name:    "out of range",
+			refs:    LinkReferences{"": {"Math": {{Start: 50, Length: 20}}}},
+			libs:    map[string]common.Address{"Math": math},
+			wantErr: true,
+		},
+		{
+			name: "no references",
+			libs: map[string]common.Address{"Math": math},
+			want: code,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Link(code, tt.refs, tt.libs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantUnresolved != nil {
+				u, ok := err.(*UnresolvedLibrariesError)
+				if !ok || !reflect.DeepEqual(u.Libraries, tt.wantUnresolved) {
+					t.Errorf("error = %v, want unresolved %v", err, tt.wantUnresolved)
+				}
+			}
+			if err == nil && !bytes.Equal(got, tt.want) {
+				t.Errorf("Link = %x, want %x", got, tt.want)
+			}
+		})
+	}
+	if code[1] != 0 {
+		t.Error("Link modified its input")
+	}
+}
@@ -0,0 +1,281 @@
+package contracts
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Metadata hash types solc embeds in bytecode.
+const (
+	MetadataIPFS  = "ipfs"
+	MetadataBzzr0 = "bzzr0"
+	MetadataBzzr1 = "bzzr1"
+)
+
+// BytecodeMetadata is the CBOR encoded metadata solc appends to runtime
+// code, see https://docs.soliditylang.org/en/latest/metadata.html.
+type BytecodeMetadata struct {
+	// HashType is ipfs, bzzr0 or bzzr1.
+	HashType string
+	// Hash is an IPFS CIDv0 or a hex encoded Swarm hash.
+	Hash string
+	// SolcVersion is only embedded by solc 0.5.9 and later.
+	SolcVersion  string
+	Experimental bool
+}
+
+// SplitMetadata splits runtime code into the executable code and the CBOR
+// encoded metadata solc appends to it, which ends with its own length as
+// two big endian bytes. Code without recognisable metadata is returned
+// unchanged.
+func SplitMetadata(code []byte) ([]byte, []byte) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	// The metadata is a CBOR map.
+	if n == 0 || start < 0 || code[start]&0xe0 != 0xa0 {
+		return code, nil
+	}
+	return code[:start], code[start:]
+}
+
+// DecodeBytecodeMetadata decodes the metadata at the end of code. It
+// returns nil if the code carries none.
+func DecodeBytecodeMetadata(code []byte) (*BytecodeMetadata, error) {
+	_, meta := SplitMetadata(code)
+	if meta == nil {
+		return nil, nil
+	}
+
+	fields, err := decodeCBORMap(meta[:len(meta)-2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode metadata: %v", err)
+	}
+
+	m := &BytecodeMetadata{}
+	for _, typ := range []string{MetadataIPFS, MetadataBzzr1, MetadataBzzr0} {
+		v, ok := fields[typ].([]byte)
+		if !ok {
+			continue
+		}
+		m.HashType = typ
+		if typ == MetadataIPFS {
+			m.Hash = base58Encode(v)
+		} else {
+			m.Hash = hex.EncodeToString(v)
+		}
+		break
+	}
+	switch v := fields["solc"].(type) {
+	case []byte:
+		// Releases are encoded as three bytes, nightlies as a string.
+		if len(v) == 3 {
+			m.SolcVersion = fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2])
+		}
+	case string:
+		m.SolcVersion = v
+	}
+	m.Experimental, _ = fields["experimental"].(bool)
+
+	if m.HashType == "" && m.SolcVersion == "" {
+		return nil, errors.New("invalid bytecode metadata: neither a metadata hash nor a compiler version")
+	}
+	return m, nil
+}
+
+// Check compares the embedded hash and compiler version with the solc
+// metadata JSON the contract was uploaded with. Only IPFS hashes are
+// checked, Swarm hashes are compared by compiler version alone.
+func (m *BytecodeMetadata) Check(metadataJSON []byte) error {
+	var parsed struct {
+		Compiler struct {
+			Version string `json:"version"`
+		} `json:"compiler"`
+	}
+	if err := json.Unmarshal(metadataJSON, &parsed); err != nil {
+		return fmt.Errorf("invalid metadata: %v", err)
+	}
+
+	if m.SolcVersion != "" && parsed.Compiler.Version != "" {
+		version := strings.SplitN(parsed.Compiler.Version, "+", 2)[0]
+		if version != m.SolcVersion && parsed.Compiler.Version != m.SolcVersion {
+			return fmt.Errorf("bytecode was compiled with solc %v, the metadata names %v", m.SolcVersion, parsed.Compiler.Version)
+		}
+	}
+
+	if m.HashType == MetadataIPFS {
+		if hash, ok := ipfsHash(metadataJSON); ok && hash != m.Hash {
+			return fmt.Errorf("bytecode embeds metadata hash %v, the metadata hashes to %v", m.Hash, hash)
+		}
+	}
+	return nil
+}
+
+// ipfsMaxChunk is the size up to which IPFS stores a file as a single block.
+const ipfsMaxChunk = 256 * 1024
+
+// ipfsHash returns the IPFS CIDv0 of data as added with the default
+// settings, the way solc computes the metadata hash. Files which IPFS
+// would split into several blocks are not supported.
+func ipfsHash(data []byte) (string, bool) {
+	if len(data) > ipfsMaxChunk {
+		return "", false
+	}
+
+	// UnixFS Data{Type: File, Data: data, filesize: len(data)}
+	var unixfs bytes.Buffer
+	unixfs.Write([]byte{0x08, 0x02})
+	unixfs.WriteByte(0x12)
+	unixfs.Write(uvarint(uint64(len(data))))
+	unixfs.Write(data)
+	unixfs.WriteByte(0x18)
+	unixfs.Write(uvarint(uint64(len(data))))
+
+	// dag-pb PBNode{Data: unixfs}
+	var node bytes.Buffer
+	node.WriteByte(0x0a)
+	node.Write(uvarint(uint64(unixfs.Len())))
+	node.Write(unixfs.Bytes())
+
+	digest := sha256.Sum256(node.Bytes())
+	// sha2-256 multihash
+	return base58Encode(append([]byte{0x12, 0x20}, digest[:]...)), true
+}
+
+func uvarint(v uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, v)]
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	base, mod := big.NewInt(58), new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// decodeCBORMap decodes a CBOR map with text keys and byte string, text,
+// unsigned integer or boolean values, which covers what solc emits.
+func decodeCBORMap(data []byte) (map[string]interface{}, error) {
+	d := &cborDecoder{data: data}
+	major, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	if major != 5 {
+		return nil, errors.New("not a map")
+	}
+
+	fields := make(map[string]interface{}, n)
+	for i := uint64(0); i < n; i++ {
+		key, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		k, ok := key.(string)
+		if !ok {
+			return nil, errors.New("map key is not a string")
+		}
+		if fields[k], err = d.value(); err != nil {
+			return nil, err
+		}
+	}
+	if d.pos != len(d.data) {
+		return nil, errors.New("trailing data")
+	}
+	return fields, nil
+}
+
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+// head reads the major type and argument of the next item.
+func (d *cborDecoder) head() (byte, uint64, error) {
+	if d.pos >= len(d.data) {
+		return 0, 0, errors.New("unexpected end")
+	}
+	b := d.data[d.pos]
+	d.pos++
+	major, info := b>>5, b&0x1f
+
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported additional information %d", info)
+	}
+	if d.pos+size > len(d.data) {
+		return 0, 0, errors.New("unexpected end")
+	}
+	var v uint64
+	for _, c := range d.data[d.pos : d.pos+size] {
+		v = v<<8 | uint64(c)
+	}
+	d.pos += size
+	return major, v, nil
+}
+
+func (d *cborDecoder) value() (interface{}, error) {
+	major, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		return n, nil
+	case 2, 3:
+		if n > uint64(len(d.data)-d.pos) {
+			return nil, errors.New("unexpected end")
+		}
+		b := d.data[d.pos : d.pos+int(n)]
+		d.pos += int(n)
+		if major == 3 {
+			return string(b), nil
+		}
+		return b, nil
+	case 7:
+		switch n {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported item of major type %d", major)
+}
@@ -0,0 +1,123 @@
+package contracts
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDecodeBytecodeMetadata(t *testing.T) {
+	body := common.FromHex("6080604052600080fd")
+	tests := []struct {
+		name string
+		// meta is the CBOR metadata, its length is appended.
+		meta    string
+		want    *BytecodeMetadata
+		wantErr bool
+	}{
+		{
+			name: "ipfs and release",
+			meta: "a2646970667358221220" + strings.Repeat("00", 32) + "64736f6c6343000807",
+			want: &BytecodeMetadata{HashType: MetadataIPFS, Hash: "QmNLei78zWmzUdbeRB3CiUfAizWUrbeeZh5K1rhAQKCh51", SolcVersion: "0.8.7"},
+		},
+		{
+			name: "bzzr0",
+			meta: "a165627a7a72305820" + strings.Repeat("ab", 32),
+			want: &BytecodeMetadata{HashType: MetadataBzzr0, Hash: strings.Repeat("ab", 32)},
+		},
+		{
+			name: "bzzr1 and experimental",
+			meta: "a365627a7a72315820" + strings.Repeat("cd", 32) + "6c6578706572696d656e74616cf564736f6c634300050c",
+			want: &BytecodeMetadata{HashType: MetadataBzzr1, Hash: strings.Repeat("cd", 32), SolcVersion: "0.5.12", Experimental: true},
+		},
+		{
+			name: "nightly",
+			meta: "a164736f6c6377302e382e382d6e696768746c792e323032312e382e3138",
+			want: &BytecodeMetadata{SolcVersion: "0.8.8-nightly.2021.8.18"},
+		},
+		{name: "no metadata", meta: ""},
+		{name: "unknown fields only", meta: "a163616263180a", wantErr: true},
+		{name: "truncated", meta: "a2646970667358221220000000", wantErr: true},
+		{name: "non text key", meta: "a10102", wantErr: true},
+	}
+	for _, tt := range tests {
+		code := body
+		if meta := common.FromHex(tt.meta); len(meta) > 0 {
+			code = append(append(code[:len(code):len(code)], meta...), byte(len(meta)>>8), byte(len(meta)))
+		}
+		got, err := DecodeBytecodeMetadata(code)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%v: DecodeBytecodeMetadata error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.want == nil && got != nil || tt.want != nil && (got == nil || *got != *tt.want) {
+			t.Errorf("%v: DecodeBytecodeMetadata = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIPFSHash(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		// ipfs add with the default settings
+		{data: "hello world\n", want: "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"},
+	}
+	for _, tt := range tests {
+		got, ok := ipfsHash([]byte(tt.data))
+		if !ok || got != tt.want {
+			t.Errorf("ipfsHash(%q) = %v, %v, want %v", tt.data, got, ok, tt.want)
+		}
+	}
+	if _, ok := ipfsHash(bytes.Repeat([]byte{'a'}, ipfsMaxChunk+1)); ok {
+		t.Error("ipfsHash of a file larger than a block succeeded")
+	}
+}
+
+func TestBase58Encode(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want string
+	}{
+		{b: nil, want: ""},
+		{b: []byte{0}, want: "1"},
+		{b: []byte{0, 0, 1}, want: "112"},
+		{b: []byte{57}, want: "z"},
+		{b: []byte{58}, want: "21"},
+		{b: []byte("hello world"), want: "StV1DL6CwTryKyV"},
+	}
+	for _, tt := range tests {
+		if got := base58Encode(tt.b); got != tt.want {
+			t.Errorf("base58Encode(%x) = %v, want %v", tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBytecodeMetadataCheck(t *testing.T) {
+	metadataJSON := []byte("hello world\n")
+	m := &BytecodeMetadata{HashType: MetadataIPFS, Hash: "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"}
+	if err := m.Check(metadataJSON); err == nil {
+		t.Error("Check of invalid JSON succeeded")
+	}
+
+	metadataJSON = []byte(`{"compiler":{"version":"0.8.7+commit.e28d00a7"}}`)
+	hash, _ := ipfsHash(metadataJSON)
+	tests := []struct {
+		name    string
+		m       BytecodeMetadata
+		wantErr bool
+	}{
+		{name: "match", m: BytecodeMetadata{HashType: MetadataIPFS, Hash: hash, SolcVersion: "0.8.7"}},
+		{name: "other hash", m: BytecodeMetadata{HashType: MetadataIPFS, Hash: "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", SolcVersion: "0.8.7"}, wantErr: true},
+		{name: "other compiler", m: BytecodeMetadata{HashType: MetadataIPFS, Hash: hash, SolcVersion: "0.8.6"}, wantErr: true},
+		{name: "swarm", m: BytecodeMetadata{HashType: MetadataBzzr1, Hash: "00", SolcVersion: "0.8.7"}},
+	}
+	for _, tt := range tests {
+		if err := tt.m.Check(metadataJSON); (err != nil) != tt.wantErr {
+			t.Errorf("%v: Check error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//go:generate go run solidity/generate.go -o multicall_code_test.go multicall3Artifact=test/Multicall3.sol:Multicall3 throwerArtifact=test/Thrower.sol:Thrower
+
+// MaxBatchCalls is the most calls a batch may contain.
+const MaxBatchCalls = 200
+
+// batchConcurrency bounds the calls sent at once without Multicall3.
+const batchConcurrency = 8
+
+// Multicall3Address is the address of Multicall3, which is deployed at the
+// same address on most networks with a presigned transaction.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABI = `[
+	{"type":"function","name":"aggregate3","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}],"stateMutability":"payable"}
+]`
+
+var parsedMulticall3ABI abi.ABI
+
+func init() {
+	var err error
+	if parsedMulticall3ABI, err = abi.JSON(strings.NewReader(multicall3ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// multicall3Call is the Call3 struct of Multicall3.
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// BatchCall is a read of a contract method in a batch.
+type BatchCall struct {
+	Target  common.Address
+	Method  abi.Method
+	ABIJSON string
+	Data    []byte
+}
+
+// BatchCallResult is the outcome of a call in a batch. Result holds the
+// decoded outputs of successful calls, keyed by name or position like
+// decoded arguments. Revert holds the reason of reverted calls and Error
+// why a call failed otherwise or was not made.
+type BatchCallResult struct {
+	ContractID string                 `json:"contractId,omitempty"`
+	Address    string                 `json:"address,omitempty"`
+	Method     string                 `json:"method,omitempty"`
+	Success    bool                   `json:"success"`
+	Result     map[string]interface{} `json:"result,omitempty"`
+	Revert     *RevertReason          `json:"revertReason,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// CallBatch is a batch of reads executed at one block. Multicall reports
+// whether the calls were aggregated through Multicall3.
+type CallBatch struct {
+	Network     string             `json:"network"`
+	BlockNumber uint64             `json:"blockNumber"`
+	Multicall   bool               `json:"multicall"`
+	Results     []*BatchCallResult `json:"results"`
+}
+
+// ExecuteBatch executes calls at block and stores their outcome in
+// results, which has an entry for each call. Calls are aggregated into one
+// Multicall3 call if the network has it at block, and sent concurrently if
+// it does not or the aggregated call fails. It returns whether Multicall3
+// was used.
+func ExecuteBatch(ctx context.Context, client *Client, calls []*BatchCall, results []*BatchCallResult, block *big.Int) (bool, error) {
+	if len(calls) == 0 {
+		return false, nil
+	}
+
+	code, err := client.CodeAt(ctx, Multicall3Address, block)
+	if err != nil {
+		return false, err
+	}
+	if len(code) > 0 {
+		if err := multicall(ctx, client, calls, results, block); err == nil {
+			return true, nil
+		}
+	}
+
+	sem := make(chan struct{}, batchConcurrency)
+	var wg sync.WaitGroup
+	for i := range calls {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+
+			c := calls[i]
+			out, err := client.CallContract(ctx, ethereum.CallMsg{To: &c.Target, Data: c.Data}, block)
+			if err != nil {
+				if reason, ok := RevertFromError(err, c.ABIJSON); ok {
+					results[i].Revert = reason
+				} else {
+					results[i].Error = err.Error()
+				}
+				return
+			}
+			c.decode(out, results[i])
+		}(i)
+	}
+	wg.Wait()
+	return false, nil
+}
+
+// multicall executes calls in one aggregate3 call allowing each to fail.
+func multicall(ctx context.Context, client *Client, calls []*BatchCall, results []*BatchCallResult, block *big.Int) error {
+	call3 := make([]multicall3Call, len(calls))
+	for i, c := range calls {
+		call3[i] = multicall3Call{Target: c.Target, AllowFailure: true, CallData: c.Data}
+	}
+	data, err := parsedMulticall3ABI.Pack("aggregate3", call3)
+	if err != nil {
+		return err
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &Multicall3Address, Data: data}, block)
+	if err != nil {
+		return err
+	}
+	values, err := parsedMulticall3ABI.Methods["aggregate3"].Outputs.UnpackValues(out)
+	if err != nil {
+		return err
+	}
+
+	// The results are an unnamed struct type built by the abi package.
+	returned := reflect.ValueOf(values[0])
+	if returned.Len() != len(calls) {
+		return fmt.Errorf("multicall returned %d results for %d calls", returned.Len(), len(calls))
+	}
+	for i, c := range calls {
+		r := returned.Index(i)
+		data := r.FieldByName("ReturnData").Bytes()
+		if !r.FieldByName("Success").Bool() {
+			results[i].Revert = DecodeRevert(c.ABIJSON, data)
+			continue
+		}
+		c.decode(data, results[i])
+	}
+	return nil
+}
+
+// decode stores the decoded outputs of a successful call in res.
+func (c *BatchCall) decode(out []byte, res *BatchCallResult) {
+	if len(out) == 0 && len(c.Method.Outputs) > 0 {
+		res.Error = fmt.Sprintf("%v returned no data, %v has no code or does not implement it", c.Method.RawName, c.Target.Hex())
+		return
+	}
+	values, err := c.Method.Outputs.UnpackValues(out)
+	if err != nil {
+		res.Error = fmt.Sprintf("%v: %v", c.Method.RawName, err)
+		return
+	}
+	res.Success = true
+	res.Result = namedArgs(c.Method.Outputs, values)
+}
+
+// ParseBlockNumber parses a block number given as decimal or 0x hex.
+// latest and the empty string return nil.
+func ParseBlockNumber(s string) (*big.Int, error) {
+	if s == "" || s == "latest" {
+		return nil, nil
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok || n.Sign() < 0 || !n.IsUint64() {
+		return nil, errors.New("invalid block number " + s)
+	}
+	return n, nil
+}
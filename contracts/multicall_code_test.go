@@ -0,0 +1,15 @@
+// Code generated by solidity/generate.go from test/Multicall3.sol, test/Thrower.sol. DO NOT EDIT.
+
+package contracts
+
+// multicall3Artifact is the artifact of Multicall3 in test/Multicall3.sol.
+//
+// The aggregate3 function of Multicall3
+// (https://github.com/mds1/multicall), which the batch tests place at its
+// address.
+const multicall3Artifact = `{"contractName":"Multicall3","sourceName":"test/Multicall3.sol","abi":[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}],"bytecode":"0x608060405234801561001057600080fd5b5061040e806100206000396000f3fe60806040526004361061001e5760003560e01c806382ad56cb14610023575b600080fd5b6100366100313660046101dd565b61004c565b6040516100439190610252565b60405180910390f35b6060818067ffffffffffffffff811115610068576100686102fe565b6040519080825280602002602001820160405280156100ae57816020015b6040805180820190915260008152606060208201528152602001906001900390816100865790505b5091503660005b828110156101d45760008482815181106100d1576100d1610314565b602002602001015190508686838181106100ed576100ed610314565b90506020028101906100ff919061032a565b925061010e602084018461034a565b6001600160a01b0316610124604085018561037a565b6040516101329291906103c8565b6000604051808303816000865af19150503d806000811461016f576040519150601f19603f3d011682016040523d82523d6000602084013e610174565b606091505b5060208084019190915290151580835290840135176101cb5762461bcd60e51b600052602060045260176024527f4d756c746963616c6c333a2063616c6c206661696c656400000000000000000060445260646000fd5b506001016100b5565b50505092915050565b600080602083850312156101f057600080fd5b823567ffffffffffffffff8082111561020857600080fd5b818501915085601f83011261021c57600080fd5b81358181111561022b57600080fd5b8660208260051b850101111561024057600080fd5b60209290920196919550909350505050565b60006020808301818452808551808352604092508286019150828160051b8701018488016000805b848110156102ef57898403603f1901865282518051151585528801518885018890528051888601819052835b818110156102c2578281018b0151878201606001528a016102a6565b508581016060908101859052978a0197601f909101601f191690950190940193509187019160010161027a565b50919998505050505050505050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60008235605e1983360301811261034057600080fd5b9190910192915050565b60006020828403121561035c57600080fd5b81356001600160a01b038116811461037357600080fd5b9392505050565b6000808335601e1984360301811261039157600080fd5b83018035915067ffffffffffffffff8211156103ac57600080fd5b6020019150368190038213156103c157600080fd5b9250929050565b818382376000910190815291905056fea2646970667358221220d83b84dd1b6cb91b4c59f502bfbffe371bce5333a270250ea7217dbc35aaef0764736f6c63430008150033","deployedBytecode":"0x60806040526004361061001e5760003560e01c806382ad56cb14610023575b600080fd5b6100366100313660046101dd565b61004c565b6040516100439190610252565b60405180910390f35b6060818067ffffffffffffffff811115610068576100686102fe565b6040519080825280602002602001820160405280156100ae57816020015b6040805180820190915260008152606060208201528152602001906001900390816100865790505b5091503660005b828110156101d45760008482815181106100d1576100d1610314565b602002602001015190508686838181106100ed576100ed610314565b90506020028101906100ff919061032a565b925061010e602084018461034a565b6001600160a01b0316610124604085018561037a565b6040516101329291906103c8565b6000604051808303816000865af19150503d806000811461016f576040519150601f19603f3d011682016040523d82523d6000602084013e610174565b606091505b5060208084019190915290151580835290840135176101cb5762461bcd60e51b600052602060045260176024527f4d756c746963616c6c333a2063616c6c206661696c656400000000000000000060445260646000fd5b506001016100b5565b50505092915050565b600080602083850312156101f057600080fd5b823567ffffffffffffffff8082111561020857600080fd5b818501915085601f83011261021c57600080fd5b81358181111561022b57600080fd5b8660208260051b850101111561024057600080fd5b60209290920196919550909350505050565b60006020808301818452808551808352604092508286019150828160051b8701018488016000805b848110156102ef57898403603f1901865282518051151585528801518885018890528051888601819052835b818110156102c2578281018b0151878201606001528a016102a6565b508581016060908101859052978a0197601f909101601f191690950190940193509187019160010161027a565b50919998505050505050505050565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60008235605e1983360301811261034057600080fd5b9190910192915050565b60006020828403121561035c57600080fd5b81356001600160a01b038116811461037357600080fd5b9392505050565b6000808335601e1984360301811261039157600080fd5b83018035915067ffffffffffffffff8211156103ac57600080fd5b6020019150368190038213156103c157600080fd5b9250929050565b818382376000910190815291905056fea2646970667358221220d83b84dd1b6cb91b4c59f502bfbffe371bce5333a270250ea7217dbc35aaef0764736f6c63430008150033","linkReferences":{},"deployedLinkReferences":{},"immutableReferences":{},"compiler":{"version":"0.8.21+commit.d9974bed"},"metadata":"{\"compiler\":{\"version\":\"0.8.21+commit.d9974bed\"},\"language\":\"Solidity\",\"output\":{\"abi\":[{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"allowFailure\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"callData\",\"type\":\"bytes\"}],\"internalType\":\"struct Multicall3.Call3[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"aggregate3\",\"outputs\":[{\"components\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"internalType\":\"struct Multicall3.Result[]\",\"name\":\"returnData\",\"type\":\"tuple[]\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}],\"devdoc\":{\"details\":\"The aggregate3 function of Multicall3 (https://github.com/mds1/multicall), which the batch tests place at its address.\",\"kind\":\"dev\",\"methods\":{},\"version\":1},\"userdoc\":{\"kind\":\"user\",\"methods\":{},\"version\":1}},\"settings\":{\"compilationTarget\":{\"test/Multicall3.sol\":\"Multicall3\"},\"evmVersion\":\"istanbul\",\"libraries\":{},\"metadata\":{\"bytecodeHash\":\"ipfs\"},\"optimizer\":{\"enabled\":true,\"runs\":200},\"remappings\":[]},\"sources\":{\"test/Multicall3.sol\":{\"keccak256\":\"0x16799c339f95f023d1f640a9f9ec6e656aa41e1133e2ef003574ec99b2d2bf77\",\"license\":\"MIT\",\"urls\":[\"bzz-raw://3db9daddd7202ad1f1dcc708fe8e5aabb3f24892748b4c730663e4d09f87e84a\",\"dweb:/ipfs/QmdrsAwqGNAnwRAXz14XEh61d7fZ7NLEfpsReq9eNs8g9Z\"]}},\"version\":1}","storageLayout":{"storage":[],"types":null}}`
+
+// throwerArtifact is the artifact of Thrower in test/Thrower.sol.
+//
+// Target of the batch tests, which reverts with a custom error.
+const throwerArtifact = `{"contractName":"Thrower","sourceName":"test/Thrower.sol","abi":[{"inputs":[{"internalType":"uint256","name":"available","type":"uint256"},{"internalType":"uint256","name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"},{"inputs":[{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"withdraw","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"pure","type":"function"}],"bytecode":"0x608060405234801561001057600080fd5b5060c68061001f6000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c80632e1a7d4d14602d575b600080fd5b603c60383660046078565b604e565b60405190815260200160405180910390f35b60405163cf47918160e01b8152600160048201526024810182905260009060440160405180910390fd5b600060208284031215608957600080fd5b503591905056fea264697066735822122024ade461b8704ccfebdc8101733b58831fd602abc9a19e7e16e77de26b2f56e464736f6c63430008150033","deployedBytecode":"0x6080604052348015600f57600080fd5b506004361060285760003560e01c80632e1a7d4d14602d575b600080fd5b603c60383660046078565b604e565b60405190815260200160405180910390f35b60405163cf47918160e01b8152600160048201526024810182905260009060440160405180910390fd5b600060208284031215608957600080fd5b503591905056fea264697066735822122024ade461b8704ccfebdc8101733b58831fd602abc9a19e7e16e77de26b2f56e464736f6c63430008150033","linkReferences":{},"deployedLinkReferences":{},"immutableReferences":{},"compiler":{"version":"0.8.21+commit.d9974bed"},"metadata":"{\"compiler\":{\"version\":\"0.8.21+commit.d9974bed\"},\"language\":\"Solidity\",\"output\":{\"abi\":[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"available\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"required\",\"type\":\"uint256\"}],\"name\":\"InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}],\"devdoc\":{\"details\":\"Target of the batch tests, which reverts with a custom error.\",\"kind\":\"dev\",\"methods\":{},\"version\":1},\"userdoc\":{\"kind\":\"user\",\"methods\":{},\"version\":1}},\"settings\":{\"compilationTarget\":{\"test/Thrower.sol\":\"Thrower\"},\"evmVersion\":\"istanbul\",\"libraries\":{},\"metadata\":{\"bytecodeHash\":\"ipfs\"},\"optimizer\":{\"enabled\":true,\"runs\":200},\"remappings\":[]},\"sources\":{\"test/Thrower.sol\":{\"keccak256\":\"0x1dddd8b183be468b1f30ef495577e4dfa566cd642c905eb6ff20dcde24f53a05\",\"license\":\"MIT\",\"urls\":[\"bzz-raw://732b0d1aa901a4d741cc7a4eeb2bfd619271365f60c580cc239710e7cc324bbd\",\"dweb:/ipfs/Qmd7RqSUPxH9Xp6tHon8q1H3wgPY9rDdCFNdDz1gFayaHm\"]}},\"version\":1}","storageLayout":{"storage":[],"types":null}}`
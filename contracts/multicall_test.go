@@ -0,0 +1,277 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mislavio/contracter/testchain"
+)
+
+var (
+	parsedThrower    = mustParseArtifact(throwerArtifact)
+	parsedThrowerABI abi.ABI
+)
+
+func init() {
+	var err error
+	if parsedThrowerABI, err = abi.JSON(bytes.NewReader(parsedThrower.ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// batchChain returns a simulated chain with the runtime code multicall at
+// Multicall3Address, if any, a box storing 42 at block 3 and 43 at block
+// 4, and a thrower.
+func batchChain(t *testing.T, multicall []byte) (*testchain.Chain, Dialer, common.Address, common.Address) {
+	alloc := core.GenesisAlloc{}
+	if multicall != nil {
+		alloc[Multicall3Address] = core.GenesisAccount{Code: multicall, Balance: new(big.Int)}
+	}
+	c := testchain.New(t, 8000000, 1, alloc)
+	box, receipt := c.Deploy(0, 1000000, parsedBox.Bytecode)
+	thrower, receipt2 := c.Deploy(0, 1000000, parsedThrower.Bytecode)
+	if receipt.Status != types.ReceiptStatusSuccessful || receipt2.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("deployment failed")
+	}
+	storeValue(t, c, box, 42)
+	storeValue(t, c, box, 43)
+
+	cache := NewCallCache(NewMemoryCallStore(10), CallStoreMemory, c.Dial)
+	dial := func(network string) (*Client, error) {
+		return cache.Client(c.RPC(), network), nil
+	}
+	return c, dial, box, thrower
+}
+
+// batchCalls returns calls of the box and the thrower and of an address
+// without code.
+func batchCalls(t *testing.T, box common.Address, thrower common.Address) []*BatchCall {
+	call := func(target common.Address, parsed abi.ABI, abiJSON string, method string, args ...interface{}) *BatchCall {
+		return &BatchCall{Target: target, Method: parsed.Methods[method], ABIJSON: abiJSON, Data: mustPack(t, parsed, method, args...)}
+	}
+	return []*BatchCall{
+		call(box, parsedBoxABI, boxABI, "retrieve"),
+		call(box, parsedBoxABI, boxABI, "fail"),
+		call(thrower, parsedThrowerABI, string(parsedThrower.ABI), "withdraw", big.NewInt(5)),
+		call(common.HexToAddress("0x00000000000000000000000000000000000000aa"), parsedBoxABI, boxABI, "retrieve"),
+		call(box, parsedBoxABI, boxABI, "version"),
+	}
+}
+
+// batchResult formats a result as success, result, revert kind and
+// message, or error.
+func batchResult(res *BatchCallResult) string {
+	switch {
+	case res.Success:
+		return fmt.Sprintf("ok %v", res.Result)
+	case res.Revert != nil:
+		return fmt.Sprintf("revert %v %v", res.Revert.Kind, res.Revert.Message)
+	default:
+		return "error " + res.Error
+	}
+}
+
+func TestExecuteBatch(t *testing.T) {
+	multicall3 := mustParseArtifact(multicall3Artifact).DeployedBytecode
+	garbage := mustParseArtifact(garbageReceiverArtifact).DeployedBytecode
+	noData := "error retrieve returned no data, " + common.HexToAddress("0xaa").Hex() + " has no code or does not implement it"
+
+	tests := []struct {
+		name          string
+		multicall     []byte
+		block         *big.Int
+		wantMulticall bool
+		want          []string
+	}{
+		{
+			name:          "multicall3",
+			multicall:     multicall3,
+			wantMulticall: true,
+			want: []string{
+				"ok map[_0:43]",
+				"revert error Box: failed",
+				"revert custom InsufficientBalance(available=1, required=5)",
+				noData,
+				"ok map[_0:1]",
+			},
+		},
+		{
+			name:          "multicall3 pinned",
+			multicall:     multicall3,
+			block:         big.NewInt(3),
+			wantMulticall: true,
+			want: []string{
+				"ok map[_0:42]",
+				"revert error Box: failed",
+				"revert custom InsufficientBalance(available=1, required=5)",
+				noData,
+				"ok map[_0:1]",
+			},
+		},
+		{
+			// The simulated node does not return revert data, so custom
+			// errors of calls sent on their own are not decoded.
+			name: "without multicall3",
+			want: []string{
+				"ok map[_0:43]",
+				"revert error Box: failed",
+				"revert unknown execution reverted without a reason",
+				noData,
+				"ok map[_0:1]",
+			},
+		},
+		{
+			name:  "without multicall3 pinned",
+			block: big.NewInt(3),
+			want: []string{
+				"ok map[_0:42]",
+				"revert error Box: failed",
+				"revert unknown execution reverted without a reason",
+				noData,
+				"ok map[_0:1]",
+			},
+		},
+		{
+			name:      "invalid multicall3 output",
+			multicall: garbage,
+			want: []string{
+				"ok map[_0:43]",
+				"revert error Box: failed",
+				"revert unknown execution reverted without a reason",
+				noData,
+				"ok map[_0:1]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		_, dial, box, thrower := batchChain(t, tt.multicall)
+		client, _ := dial("simulated")
+		calls := batchCalls(t, box, thrower)
+		results := make([]*BatchCallResult, len(calls))
+		for i := range results {
+			results[i] = &BatchCallResult{}
+		}
+
+		multicall, err := ExecuteBatch(context.Background(), client, calls, results, tt.block)
+		if err != nil {
+			t.Errorf("%v: ExecuteBatch() error = %v", tt.name, err)
+			continue
+		}
+		if multicall != tt.wantMulticall {
+			t.Errorf("%v: ExecuteBatch() multicall = %v, want %v", tt.name, multicall, tt.wantMulticall)
+		}
+		for i, res := range results {
+			if got := batchResult(res); got != tt.want[i] {
+				t.Errorf("%v: call %d = %v, want %v", tt.name, i, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestMulticallPacking(t *testing.T) {
+	box := common.HexToAddress("0x00000000000000000000000000000000000000b0")
+	calls := batchCalls(t, box, box)[:2]
+	call3 := make([]multicall3Call, len(calls))
+	for i, c := range calls {
+		call3[i] = multicall3Call{Target: c.Target, AllowFailure: true, CallData: c.Data}
+	}
+	data, err := parsedMulticall3ABI.Pack("aggregate3", call3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The calldata matches the ABI of the Multicall3 contract.
+	parsed, err := abi.JSON(bytes.NewReader(mustParseArtifact(multicall3Artifact).ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data[:4], parsed.Methods["aggregate3"].ID()) {
+		t.Errorf("aggregate3 selector = %x, want %x", data[:4], parsed.Methods["aggregate3"].ID())
+	}
+	values, err := parsed.Methods["aggregate3"].Inputs.UnpackValues(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed := reflect.ValueOf(values[0])
+	if packed.Len() != len(calls) {
+		t.Fatalf("aggregate3 packed %d calls, want %d", packed.Len(), len(calls))
+	}
+	for i, c := range calls {
+		p := packed.Index(i)
+		if p.FieldByName("Target").Interface() != c.Target || !p.FieldByName("AllowFailure").Bool() || !bytes.Equal(p.FieldByName("CallData").Bytes(), c.Data) {
+			t.Errorf("aggregate3 call %d = %+v, want %v allowed to fail with %x", i, p.Interface(), c.Target.Hex(), c.Data)
+		}
+	}
+}
+
+func TestExecuteCallBatch(t *testing.T) {
+	c, dial, box, thrower := batchChain(t, mustParseArtifact(multicall3Artifact).DeployedBytecode)
+	calls := batchCalls(t, box, thrower)[:1]
+
+	tests := []struct {
+		block     *big.Int
+		wantBlock uint64
+		want      string
+	}{
+		{wantBlock: 4, want: "ok map[_0:43]"},
+		{block: big.NewInt(3), wantBlock: 3, want: "ok map[_0:42]"},
+	}
+	for _, tt := range tests {
+		batch := &CallBatch{Network: "simulated"}
+		results := []*BatchCallResult{{}}
+		if err := executeCallBatch(context.Background(), dial, batch, calls, results, tt.block); err != nil {
+			t.Fatal(err)
+		}
+		if batch.BlockNumber != tt.wantBlock || !batch.Multicall || batchResult(results[0]) != tt.want {
+			t.Errorf("executeCallBatch(%v) = block %v, multicall %v, %v, want block %v, %v", tt.block, batch.BlockNumber, batch.Multicall, batchResult(results[0]), tt.wantBlock, tt.want)
+		}
+	}
+
+	// A block the chain does not have fails the batch.
+	batch := &CallBatch{Network: "simulated"}
+	if err := executeCallBatch(context.Background(), dial, batch, calls, []*BatchCallResult{{}}, big.NewInt(int64(c.Blockchain().CurrentBlock().NumberU64())+10)); err == nil {
+		t.Error("executeCallBatch() of a future block succeeded")
+	}
+}
+
+func TestBatchCallsPayload(t *testing.T) {
+	id := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{}`, "missing calls"},
+		{`{"calls":[null]}`, "call 0: missing"},
+		{`{"calls":[{"contractId":"x","method":"m"}]}`, "call 0: invalid contract id"},
+		{`{"calls":[{"contractId":"` + id + `","address":"0x12","method":"m"}]}`, "call 0: invalid address 0x12"},
+		{`{"calls":[{"contractId":"` + id + `"}]}`, "call 0: missing method"},
+		{`{"block":"soon","calls":[{"contractId":"` + id + `","method":"m"}]}`, "invalid block number soon"},
+		{`{"calls":[` + strings.Repeat(`{"contractId":"`+id+`","method":"m"},`, MaxBatchCalls) + `{"contractId":"` + id + `","method":"m"}]}`, "more than 200 calls"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/calls/batch", strings.NewReader(tt.body))
+		r.Header.Set("Content-Type", "application/json")
+		BatchCalls(nil, nil).ServeHTTP(w, r)
+
+		var res struct {
+			Detail string `json:"detail"`
+		}
+		json.NewDecoder(w.Body).Decode(&res)
+		if w.Code != http.StatusBadRequest || res.Detail != tt.want {
+			t.Errorf("BatchCalls(%.60v) = %v %q, want 400 %q", tt.body, w.Code, res.Detail, tt.want)
+		}
+	}
+}
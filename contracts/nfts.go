@@ -0,0 +1,494 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// NFT standards
+const (
+	StandardERC721  = "erc721"
+	StandardERC1155 = "erc1155"
+)
+
+// ERC-165 interface IDs.
+var (
+	InterfaceERC721         = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	InterfaceERC721Metadata = [4]byte{0x5b, 0x5e, 0x13, 0x9f}
+	InterfaceERC1155        = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+	InterfaceERC1155URI     = [4]byte{0x0e, 0x89, 0x34, 0x1c}
+)
+
+// nftIndexChunk is the number of blocks requested per log query when
+// indexing owners. Public nodes limit the size of log responses.
+const nftIndexChunk = 5000
+
+// nftIndexConfirmations is how many blocks must follow a block before its
+// Transfer events are indexed. Owners are never rewound, so blocks which
+// may still be reorganised away are left for a later run.
+const nftIndexConfirmations = 12
+
+// mintMethods are the method names a mint request may call.
+var mintMethods = []string{"safeMint", "mint"}
+
+// erc721ABI and erc1155ABI are the parts of the standards the NFT
+// endpoints use. Calls are packed with them instead of the stored ABI,
+// which may overload safeTransferFrom. Only the variant with data is
+// declared for ERC-721, an empty data argument is equivalent.
+const erc721ABI = `[
+	{"type":"function","name":"supportsInterface","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"name","inputs":[],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"symbol","inputs":[],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"tokenURI","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"ownerOf","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"},
+	{"type":"function","name":"getApproved","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"},
+	{"type":"function","name":"isApprovedForAll","inputs":[{"name":"owner","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"},{"name":"data","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"approve","inputs":[{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}],"anonymous":false}
+]`
+
+const erc1155ABI = `[
+	{"type":"function","name":"supportsInterface","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"uri","inputs":[{"name":"id","type":"uint256"}],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"},
+	{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"},{"name":"id","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"isApprovedForAll","inputs":[{"name":"account","type":"address"},{"name":"operator","type":"address"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"amount","type":"uint256"},{"name":"data","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"safeBatchTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"ids","type":"uint256[]"},{"name":"amounts","type":"uint256[]"},{"name":"data","type":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},
+	{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[],"stateMutability":"nonpayable"}
+]`
+
+var (
+	parsedERC721ABI  abi.ABI
+	parsedERC1155ABI abi.ABI
+)
+
+func init() {
+	var err error
+	if parsedERC721ABI, err = abi.JSON(strings.NewReader(erc721ABI)); err != nil {
+		panic(err)
+	}
+	if parsedERC1155ABI, err = abi.JSON(strings.NewReader(erc1155ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// ParseTokenID parses a token ID given as a decimal or 0x prefixed hex
+// number.
+func ParseTokenID(s string) (*big.Int, error) {
+	v, ok := math.ParseBig256(s)
+	if !ok || s == "" || v.Sign() < 0 {
+		return nil, fmt.Errorf("invalid token id %q", s)
+	}
+	return v, nil
+}
+
+// NFT is an ERC-721 or ERC-1155 contract linked to an account at its
+// address on a network. ABI is the standard ABI of its kind.
+type NFT struct {
+	Contract   *Contract
+	Network    string
+	Address    common.Address
+	Standard   string
+	ABI        abi.ABI
+	Interfaces []string
+	// Metadata reports whether the collection implements the metadata
+	// extension of its standard.
+	Metadata bool
+}
+
+// LoadNFT returns the NFT collection of a contract linked to the account at
+// the address deployedAddress picks on network. Its standard is detected
+// with ERC-165 supportsInterface, so the call needs the network.
+func LoadNFT(ctx context.Context, accountID string, id string, network string, dial Dialer, db *gorm.DB) (*NFT, *Client, error) {
+	m := &MyContract{}
+	if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(accountID, id, db) {
+		return nil, nil, helpers.ErrNotFound("contract", id)
+	}
+	c := &m.Contract
+
+	network, address, err := c.deployedAddress(accountID, network, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := dial(network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := &NFT{Contract: c, Network: network, Address: address, Interfaces: []string{}}
+	if err := n.detect(ctx, client); err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return n, client, nil
+}
+
+// detect queries the interfaces the contract supports. Contracts without
+// supportsInterface revert, which is reported as not being an NFT.
+func (n *NFT) detect(ctx context.Context, client *Client) error {
+	supports := func(id [4]byte) bool {
+		values, err := callView(ctx, client, n.Address, parsedERC721ABI, "", "supportsInterface", id)
+		return err == nil && values[0].(bool)
+	}
+
+	switch {
+	case supports(InterfaceERC721):
+		n.Standard, n.ABI = StandardERC721, parsedERC721ABI
+		n.Interfaces = append(n.Interfaces, hexutil.Encode(InterfaceERC721[:]))
+		if n.Metadata = supports(InterfaceERC721Metadata); n.Metadata {
+			n.Interfaces = append(n.Interfaces, hexutil.Encode(InterfaceERC721Metadata[:]))
+		}
+	case supports(InterfaceERC1155):
+		n.Standard, n.ABI = StandardERC1155, parsedERC1155ABI
+		n.Interfaces = append(n.Interfaces, hexutil.Encode(InterfaceERC1155[:]))
+		if n.Metadata = supports(InterfaceERC1155URI); n.Metadata {
+			n.Interfaces = append(n.Interfaces, hexutil.Encode(InterfaceERC1155URI[:]))
+		}
+	default:
+		return helpers.ErrNotNFT(fmt.Errorf("%v on %v supports neither ERC-721 nor ERC-1155 through ERC-165", n.Address.Hex(), n.Network))
+	}
+	return nil
+}
+
+// MintCalldata returns the calldata of a call of the mint method of the
+// stored ABI, a method named safeMint or mint unless method names another
+// one. Mint methods are not standardized, so the arguments are those of
+// the contract.
+func (n *NFT) MintCalldata(method string, args []json.RawMessage) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(string(n.Contract.ABI.RawMessage)))
+	if err != nil {
+		return nil, helpers.ErrABIInvalid(err)
+	}
+	method, err = mintMethod(parsed, method)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	data, err := PackCall(parsed, method, args)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	return data, nil
+}
+
+// mintMethod returns method if parsed has it, or else the first mint
+// method of parsed.
+func mintMethod(parsed abi.ABI, method string) (string, error) {
+	names := mintMethods
+	if method != "" {
+		names = []string{method}
+	}
+	for _, name := range names {
+		if _, err := FindMethod(parsed, name); err == nil {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("the ABI has no %v method", strings.Join(names, " or "))
+}
+
+// NFTCollection describes an NFT collection.
+type NFTCollection struct {
+	ContractID string   `json:"contractId"`
+	Network    string   `json:"network"`
+	Address    string   `json:"address"`
+	Standard   string   `json:"standard"`
+	Interfaces []string `json:"interfaces"`
+	Name       string   `json:"name,omitempty"`
+	Symbol     string   `json:"symbol,omitempty"`
+	// Mintable reports whether the ABI has a mint method.
+	Mintable bool `json:"mintable"`
+}
+
+// Collection returns the description of n. ERC-1155 has no name or
+// symbol, ERC-721 only with the metadata extension.
+func (n *NFT) Collection(ctx context.Context, client *Client) (*NFTCollection, error) {
+	c := &NFTCollection{
+		ContractID: n.Contract.ID.String(),
+		Network:    n.Network,
+		Address:    n.Address.Hex(),
+		Standard:   n.Standard,
+		Interfaces: n.Interfaces,
+	}
+	if parsed, err := abi.JSON(strings.NewReader(string(n.Contract.ABI.RawMessage))); err == nil {
+		_, err = mintMethod(parsed, "")
+		c.Mintable = err == nil
+	}
+
+	if n.Standard == StandardERC721 && n.Metadata {
+		for _, f := range []struct {
+			method string
+			dst    *string
+		}{{"name", &c.Name}, {"symbol", &c.Symbol}} {
+			values, err := callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), f.method)
+			if err != nil {
+				return nil, err
+			}
+			*f.dst = values[0].(string)
+		}
+	}
+	return c, nil
+}
+
+// NFTToken describes a token of a collection. Owner is only known for
+// ERC-721, ERC-1155 tokens can have many holders.
+type NFTToken struct {
+	TokenID  string `json:"tokenId"`
+	Owner    string `json:"owner,omitempty"`
+	Approved string `json:"approved,omitempty"`
+	URI      string `json:"uri,omitempty"`
+}
+
+// Token returns the owner, approved address and metadata URI of a token.
+// ERC-1155 URIs are returned as is, clients substitute {id} themselves.
+func (n *NFT) Token(ctx context.Context, client *Client, id *big.Int) (*NFTToken, error) {
+	t := &NFTToken{TokenID: id.String()}
+
+	if n.Standard == StandardERC721 {
+		values, err := callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), "ownerOf", id)
+		if err != nil {
+			return nil, err
+		}
+		t.Owner = values[0].(common.Address).Hex()
+		if values, err = callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), "getApproved", id); err != nil {
+			return nil, err
+		}
+		if approved := values[0].(common.Address); approved != (common.Address{}) {
+			t.Approved = approved.Hex()
+		}
+	}
+
+	if n.Metadata {
+		method := "tokenURI"
+		if n.Standard == StandardERC1155 {
+			method = "uri"
+		}
+		values, err := callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), method, id)
+		if err != nil {
+			return nil, err
+		}
+		t.URI = values[0].(string)
+	}
+	return t, nil
+}
+
+// BalanceOf returns the number of tokens owner holds. ERC-1155 balances
+// are per token ID, which is ignored for ERC-721.
+func (n *NFT) BalanceOf(ctx context.Context, client *Client, owner common.Address, id *big.Int) (*big.Int, error) {
+	args := []interface{}{owner}
+	if n.Standard == StandardERC1155 {
+		if id == nil {
+			return nil, helpers.ErrBadRequest(fmt.Errorf("ERC-1155 balances need a tokenId"))
+		}
+		args = append(args, id)
+	}
+	values, err := callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), "balanceOf", args...)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].(*big.Int), nil
+}
+
+// IsApprovedForAll reports whether operator may transfer all tokens of owner.
+func (n *NFT) IsApprovedForAll(ctx context.Context, client *Client, owner common.Address, operator common.Address) (bool, error) {
+	values, err := callView(ctx, client, n.Address, n.ABI, string(n.Contract.ABI.RawMessage), "isApprovedForAll", owner, operator)
+	if err != nil {
+		return false, err
+	}
+	return values[0].(bool), nil
+}
+
+// TransferCalldata returns the calldata of a safe transfer of one token.
+// amount is ignored for ERC-721.
+func (n *NFT) TransferCalldata(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) ([]byte, error) {
+	if data == nil {
+		data = []byte{}
+	}
+	if n.Standard == StandardERC721 {
+		return n.ABI.Pack("safeTransferFrom", from, to, id, data)
+	}
+	return n.ABI.Pack("safeTransferFrom", from, to, id, amount, data)
+}
+
+// BatchTransferCalldata returns the calldata of an ERC-1155 batch transfer.
+func (n *NFT) BatchTransferCalldata(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) ([]byte, error) {
+	if data == nil {
+		data = []byte{}
+	}
+	return n.ABI.Pack("safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// NFTOwner is the current owner of an ERC-721 token as indexed from its
+// Transfer events.
+type NFTOwner struct {
+	helpers.BaseModel
+	ContractID      string `json:"contractId"`
+	Network         string `json:"network" gorm:"unique_index:idx_nft_owner"`
+	Address         string `json:"address" gorm:"unique_index:idx_nft_owner"`
+	TokenID         string `json:"tokenId" gorm:"unique_index:idx_nft_owner"`
+	Owner           string `json:"owner" gorm:"index"`
+	BlockNumber     uint64 `json:"blockNumber"`
+	TransactionHash string `json:"transactionHash"`
+}
+
+// NFTIndex tracks how far the Transfer events of a collection were indexed.
+type NFTIndex struct {
+	helpers.BaseModel
+	Network   string `json:"network" gorm:"unique_index:idx_nft_index"`
+	Address   string `json:"address" gorm:"unique_index:idx_nft_index"`
+	FromBlock uint64 `json:"fromBlock"`
+	LastBlock uint64 `json:"lastBlock"`
+	// Head is the latest confirmed block of the network when the index
+	// last ran, the last block it indexes.
+	Head uint64 `json:"head"`
+}
+
+// Complete reports whether the index reached the head of the network the
+// last time it ran.
+func (i *NFTIndex) Complete() bool {
+	return i.LastBlock >= i.Head
+}
+
+var erc721TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// IndexOwners applies the Transfer events of an ERC-721 collection since
+// the last indexed block to its owner table. The first run starts at
+// fromBlock, by default the block of the contract's deployment, and runs
+// up to the latest block with nftIndexConfirmations confirmations.
+// Progress is stored per chunk of blocks, so indexing stops when the
+// deadline passes and continues on the next run.
+func (n *NFT) IndexOwners(ctx context.Context, client *Client, fromBlock *uint64, deadline time.Time, db *gorm.DB) (*NFTIndex, error) {
+	if n.Standard != StandardERC721 {
+		return nil, helpers.ErrBadRequest(fmt.Errorf("owners are only indexed for ERC-721, ERC-1155 tokens have balances instead"))
+	}
+
+	idx := &NFTIndex{}
+	if db.Where("network = ? AND address = ?", n.Network, n.Address.Hex()).First(idx).RecordNotFound() {
+		idx = &NFTIndex{Network: n.Network, Address: n.Address.Hex()}
+		if fromBlock != nil {
+			idx.FromBlock = *fromBlock
+		} else {
+			d := &Deployment{}
+			if !db.Where("network = ? AND address = ? AND status = ?", n.Network, n.Address.Hex(), TxSuccess).First(d).RecordNotFound() {
+				idx.FromBlock = d.BlockNumber
+			}
+		}
+		if idx.FromBlock > 0 {
+			idx.LastBlock = idx.FromBlock - 1
+		}
+		if err := db.Create(idx).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	confirmed, ok := confirmedBlock(head.Number.Uint64())
+	if !ok {
+		return idx, nil
+	}
+	idx.Head = confirmed
+
+	start := idx.LastBlock + 1
+	if idx.LastBlock == 0 && idx.FromBlock == 0 {
+		start = 0
+	}
+	for start <= idx.Head && time.Now().Before(deadline) {
+		end := start + nftIndexChunk - 1
+		if end > idx.Head {
+			end = idx.Head
+		}
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{n.Address},
+			Topics:    [][]common.Hash{{erc721TransferTopic}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			// Logs are returned in chain order, later transfers win.
+			for _, l := range logs {
+				if l.Removed || len(l.Topics) != 4 {
+					continue
+				}
+				if err := n.applyTransfer(l.Topics[2], l.Topics[3], l.BlockNumber, l.TxHash.Hex(), tx); err != nil {
+					return err
+				}
+			}
+			idx.LastBlock = end
+			return tx.Model(&NFTIndex{}).Where("id = ?", idx.ID).
+				Updates(map[string]interface{}{"last_block": end, "head": idx.Head}).Error
+		}); err != nil {
+			return nil, err
+		}
+		start = end + 1
+	}
+	return idx, nil
+}
+
+// confirmedBlock returns the latest block with nftIndexConfirmations
+// confirmations when head is the latest block, if there is one yet.
+func confirmedBlock(head uint64) (uint64, bool) {
+	if head < nftIndexConfirmations {
+		return 0, false
+	}
+	return head - nftIndexConfirmations, true
+}
+
+// applyTransfer stores the new owner of a token. Tokens sent to the zero
+// address are burned and removed.
+func (n *NFT) applyTransfer(toTopic common.Hash, idTopic common.Hash, block uint64, txHash string, db *gorm.DB) error {
+	to := common.BytesToAddress(toTopic.Bytes())
+	id := new(big.Int).SetBytes(idTopic.Bytes()).String()
+	where := db.Where("network = ? AND address = ? AND token_id = ?", n.Network, n.Address.Hex(), id)
+
+	if to == (common.Address{}) {
+		return where.Unscoped().Delete(&NFTOwner{}).Error
+	}
+
+	o := &NFTOwner{}
+	if where.First(o).RecordNotFound() {
+		return db.Create(&NFTOwner{
+			ContractID:      n.Contract.ID.String(),
+			Network:         n.Network,
+			Address:         n.Address.Hex(),
+			TokenID:         id,
+			Owner:           to.Hex(),
+			BlockNumber:     block,
+			TransactionHash: txHash,
+		}).Error
+	}
+	return db.Model(o).Updates(map[string]interface{}{"owner": to.Hex(), "block_number": block, "transaction_hash": txHash}).Error
+}
+
+// NFTOwners returns the indexed owners of a collection, optionally only
+// the tokens of owner, ordered by token ID.
+func NFTOwners(network string, address common.Address, owner string, db *gorm.DB) ([]*NFTOwner, error) {
+	q := db.Where("network = ? AND address = ?", network, address.Hex())
+	if owner != "" {
+		q = q.Where("owner = ?", common.HexToAddress(owner).Hex())
+	}
+	owners := []*NFTOwner{}
+	err := q.Order("length(token_id), token_id").Find(&owners).Error
+	return owners, err
+}
@@ -0,0 +1,22 @@
+package contracts
+
+import "testing"
+
+func TestConfirmedBlock(t *testing.T) {
+	tests := []struct {
+		head   uint64
+		want   uint64
+		wantOK bool
+	}{
+		{head: 0},
+		{head: nftIndexConfirmations - 1},
+		{head: nftIndexConfirmations, want: 0, wantOK: true},
+		{head: 10000000, want: 10000000 - nftIndexConfirmations, wantOK: true},
+	}
+	for _, tt := range tests {
+		got, ok := confirmedBlock(tt.head)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("confirmedBlock(%d) = %d, %v, want %d, %v", tt.head, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+)
+
+//go:generate go run solidity/generate.go -o proxy_code.go transparentProxyArtifact=@openzeppelin/contracts/proxy/transparent/TransparentUpgradeableProxy.sol:TransparentUpgradeableProxy proxyInterfaceArtifact=@openzeppelin/contracts/proxy/transparent/TransparentUpgradeableProxy.sol:ITransparentUpgradeableProxy erc1967ProxyArtifact=@openzeppelin/contracts/proxy/ERC1967/ERC1967Proxy.sol:ERC1967Proxy proxyAdminArtifact=@openzeppelin/contracts/proxy/transparent/ProxyAdmin.sol:ProxyAdmin
+//go:generate go run solidity/generate.go -o proxy_code_test.go boxArtifact=test/Box.sol:Box boxV2Artifact=test/Box.sol:BoxV2
+
+// Proxy kinds
+const (
+	// ProxyTransparent is an EIP-1967 transparent proxy. Its admin, a
+	// ProxyAdmin contract, can only upgrade it, calls from every other
+	// address are delegated.
+	ProxyTransparent = "transparent"
+	// ProxyUUPS is an EIP-1967 proxy whose upgrade logic lives in the
+	// implementation (EIP-1822), which must expose upgradeTo(address).
+	ProxyUUPS = "uups"
+)
+
+// ErrNotProxyAdmin is returned for an admin address which is not a
+// ProxyAdmin.
+var ErrNotProxyAdmin = errors.New("not a ProxyAdmin")
+
+// EIP-1967 storage slots.
+var (
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+)
+
+// The proxies are OpenZeppelin's TransparentUpgradeableProxy, ERC1967Proxy
+// for UUPS, and ProxyAdmin, compiled from the sources in solidity/lib.
+var (
+	transparentProxy = mustParseArtifact(transparentProxyArtifact)
+	erc1967Proxy     = mustParseArtifact(erc1967ProxyArtifact)
+)
+
+var (
+	parsedTransparentProxyABI abi.ABI
+	parsedERC1967ProxyABI     abi.ABI
+	// parsedProxyABI is the admin interface of transparent proxies, whose
+	// upgradeTo and upgradeToAndCall UUPS implementations share.
+	parsedProxyABI      abi.ABI
+	parsedProxyAdminABI abi.ABI
+)
+
+func init() {
+	var err error
+	if parsedTransparentProxyABI, err = abi.JSON(bytes.NewReader(transparentProxy.ABI)); err != nil {
+		panic(err)
+	}
+	if parsedERC1967ProxyABI, err = abi.JSON(bytes.NewReader(erc1967Proxy.ABI)); err != nil {
+		panic(err)
+	}
+	if parsedProxyABI, err = abi.JSON(bytes.NewReader(mustParseArtifact(proxyInterfaceArtifact).ABI)); err != nil {
+		panic(err)
+	}
+	if parsedProxyAdminABI, err = abi.JSON(bytes.NewReader(ProxyAdminArtifact().ABI)); err != nil {
+		panic(err)
+	}
+}
+
+// ValidProxyKind reports whether kind is a supported proxy kind.
+func ValidProxyKind(kind string) bool {
+	return kind == ProxyTransparent || kind == ProxyUUPS
+}
+
+// ProxyInitCode returns the creation code of a proxy pointing at
+// implementation which delegatecalls initData to it on deployment. The
+// admin of transparent proxies is a ProxyAdmin, it is ignored for UUPS
+// proxies.
+func ProxyInitCode(kind string, implementation common.Address, admin common.Address, initData []byte) ([]byte, error) {
+	if initData == nil {
+		initData = []byte{}
+	}
+
+	var code []byte
+	var args []byte
+	var err error
+	switch kind {
+	case ProxyTransparent:
+		code = transparentProxy.Bytecode
+		args, err = parsedTransparentProxyABI.Pack("", implementation, admin, initData)
+	case ProxyUUPS:
+		code = erc1967Proxy.Bytecode
+		args, err = parsedERC1967ProxyABI.Pack("", implementation, initData)
+	default:
+		return nil, fmt.Errorf("unknown proxy kind %v", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, code...), args...), nil
+}
+
+// UpgradeCalldata returns the calldata pointing a proxy at implementation,
+// calling initData on it in the same transaction when given. Both proxy
+// kinds share the upgradeTo and upgradeToAndCall selectors.
+func UpgradeCalldata(implementation common.Address, initData []byte) ([]byte, error) {
+	if len(initData) == 0 {
+		return parsedProxyABI.Pack("upgradeTo", implementation)
+	}
+	return parsedProxyABI.Pack("upgradeToAndCall", implementation, initData)
+}
+
+// ProxyAdminArtifact returns OpenZeppelin's ProxyAdmin, registered like an
+// uploaded artifact.
+func ProxyAdminArtifact() *Artifact {
+	return mustParseArtifact(proxyAdminArtifact)
+}
+
+// ProxyAdminContract returns the contract registered for the ProxyAdmin,
+// registering it on first use, and links it to the account.
+func ProxyAdminContract(accountID string, db *gorm.DB) (*Contract, error) {
+	return registerArtifact(accountID, ProxyAdminArtifact(), db)
+}
+
+// ProxyAdminUpgradeCalldata returns the calldata making a ProxyAdmin point
+// proxy at implementation, calling initData on it in the same transaction
+// when given.
+func ProxyAdminUpgradeCalldata(proxy common.Address, implementation common.Address, initData []byte) ([]byte, error) {
+	if len(initData) == 0 {
+		return parsedProxyAdminABI.Pack("upgrade", proxy, implementation)
+	}
+	return parsedProxyAdminABI.Pack("upgradeAndCall", proxy, implementation, initData)
+}
+
+// ChangeProxyAdminCalldata returns the calldata making a ProxyAdmin hand
+// proxy over to admin.
+func ChangeProxyAdminCalldata(proxy common.Address, admin common.Address) ([]byte, error) {
+	return parsedProxyAdminABI.Pack("changeProxyAdmin", proxy, admin)
+}
+
+// DecodeChangeProxyAdmin returns the proxy and the new admin of
+// changeProxyAdmin calldata. It returns false for other calldata.
+func DecodeChangeProxyAdmin(data []byte) (common.Address, common.Address, bool) {
+	m := parsedProxyAdminABI.Methods["changeProxyAdmin"]
+	if len(data) < 4 || !bytes.Equal(data[:4], m.ID()) {
+		return common.Address{}, common.Address{}, false
+	}
+	values, err := m.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return common.Address{}, common.Address{}, false
+	}
+	return values[0].(common.Address), values[1].(common.Address), true
+}
+
+// ProxyAdminOwner returns the owner of the ProxyAdmin at admin. It returns
+// ErrNotProxyAdmin if admin has no code or does not implement owner().
+func ProxyAdminOwner(ctx context.Context, caller ethereum.ContractCaller, admin common.Address) (common.Address, error) {
+	data, err := parsedProxyAdminABI.Pack("owner")
+	if err != nil {
+		return common.Address{}, err
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &admin, Data: data}, nil)
+	if _, reverted := RevertFromError(err, ""); err != nil && !reverted {
+		return common.Address{}, err
+	}
+	if err != nil || len(out) != common.HashLength {
+		return common.Address{}, fmt.Errorf("%v: %w", admin.Hex(), ErrNotProxyAdmin)
+	}
+	return common.BytesToAddress(out), nil
+}
+
+// CheckUUPSImplementation returns an error if an implementation ABI lacks
+// the upgrade function. Without it a UUPS proxy could never be upgraded
+// again.
+func CheckUUPSImplementation(parsed abi.ABI, withCall bool) error {
+	name, sig := "upgradeTo", "upgradeTo(address)"
+	if withCall {
+		name, sig = "upgradeToAndCall", "upgradeToAndCall(address,bytes)"
+	}
+	if m, err := FindMethod(parsed, sig); err != nil || m.RawName != name {
+		return errors.New("UUPS implementation must implement " + sig)
+	}
+	return nil
+}
+
+// Proxy represents an upgradeable proxy deployed through Contracter.
+// ContractID points at the Contract registered for the proxy address,
+// whose ABI is the ABI of the current implementation. Admin is the admin
+// of transparent proxies, the ProxyAdmin deployed with them until it is
+// changed.
+type Proxy struct {
+	helpers.BaseModel
+	Kind                  string `json:"kind"`
+	Network               string `json:"network"`
+	AccountID             string `json:"accountId"`
+	ContractID            string `json:"contractId"`
+	DeploymentID          string `json:"deploymentId"`
+	Address               string `json:"address"`
+	Admin                 string `json:"admin,omitempty"`
+	ImplementationID      string `json:"implementationId"`
+	ImplementationAddress string `json:"implementationAddress"`
+}
+
+// FindOrFalse returns false if the proxy does not exist or belongs to
+// another account.
+func (p *Proxy) FindOrFalse(id string, accountID string, db *gorm.DB) bool {
+	return db.Where("id = ? AND account_id = ?", id, accountID).Find(p).RecordNotFound()
+}
+
+// CompareLayout compares the storage layout of the current implementation
+// of p with the layout of next. The report is nil when either contract was
+// registered without a storage layout.
+func (p *Proxy) CompareLayout(next *Contract, db *gorm.DB) (*LayoutReport, error) {
+	current := &Contract{}
+	if err := db.Where("id = ?", p.ImplementationID).First(current).Error; err != nil {
+		return nil, err
+	}
+
+	prev, err := current.ParsedStorageLayout()
+	if err != nil || prev == nil {
+		return nil, err
+	}
+	layout, err := next.ParsedStorageLayout()
+	if err != nil || layout == nil {
+		return nil, err
+	}
+	return CompareStorageLayouts(prev, layout), nil
+}
+
+// ProxyImplementation is an entry in the implementation history of a
+// Proxy. ContractID is the implementation contract and TransactionHash the
+// transaction which pointed the proxy at it.
+type ProxyImplementation struct {
+	helpers.BaseModel
+	ProxyID         string `json:"proxyId"`
+	ContractID      string `json:"contractId"`
+	DeploymentID    string `json:"deploymentId"`
+	Address         string `json:"address"`
+	TransactionHash string `json:"transactionHash"`
+	Status          string `json:"status"`
+}
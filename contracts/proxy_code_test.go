@@ -0,0 +1,14 @@
+// Code generated by solidity/generate.go from test/Box.sol. DO NOT EDIT.
+
+package contracts
+
+// boxArtifact is the artifact of Box in test/Box.sol.
+//
+// Implementation deployed behind the proxies in the proxy tests.
+const boxArtifact = "{\"contractName\":\"Box\",\"sourceName\":\"test/Box.sol\",\"abi\":[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"fail\",\"outputs\":[],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"retrieve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"store\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}],\"bytecode\":\"0x60a06040523060805234801561001457600080fd5b50608051610a4961004c600039600081816101350152818161017e015281816102140152818161025401526102de0152610a496000f3fe6080604052600436106100705760003560e01c806352d1902d1161004e57806352d1902d146100cd57806354fd4d50146100e25780636057361d146100f6578063a9cc47181461011657600080fd5b80632e64cec1146100755780633659cfe6146100985780634f1ef286146100ba575b600080fd5b34801561008157600080fd5b506000545b60405190815260200160405180910390f35b3480156100a457600080fd5b506100b86100b336600461079c565b61012b565b005b6100b86100c83660046107cd565b61020a565b3480156100d957600080fd5b506100866102d1565b3480156100ee57600080fd5b506001610086565b34801561010257600080fd5b506100b861011136600461088f565b600055565b34801561012257600080fd5b506100b8610384565b6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016300361017c5760405162461bcd60e51b8152600401610173906108a8565b60405180910390fd5b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166101c56000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146101eb5760405162461bcd60e51b8152600401610173906108f4565b60408051600080825260208201909252610207918391906103ba565b50565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036102525760405162461bcd60e51b8152600401610173906108a8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661029b6000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146102c15760405162461bcd60e51b8152600401610173906108f4565b6102cd828260016103ba565b5050565b6000306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103715760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c00000000000000006064820152608401610173565b506000805160206109cd83398151915290565b60405162461bcd60e51b815260206004820152600b60248201526a109bde0e8819985a5b195960aa1b6044820152606401610173565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff16156103f2576103ed8361052a565b505050565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa92505050801561044c575060408051601f3d908101601f1916820190925261044991810190610940565b60015b6104af5760405162461bcd60e51b815260206004820152602e60248201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960448201526d6f6e206973206e6f74205555505360901b6064820152608401610173565b6000805160206109cd833981519152811461051e5760405162461bcd60e51b815260206004820152602960248201527f45524331393637557067726164653a20756e737570706f727465642070726f786044820152681a58589b195555525160ba1b6064820152608401610173565b506103ed8383836105c6565b6001600160a01b0381163b6105975760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610173565b6000805160206109cd83398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b6105cf836105f1565b6000825111806105dc5750805b156103ed576105eb8383610631565b50505050565b6105fa8161052a565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b606061065683836040518060600160405280602781526020016109ed6027913961065d565b9392505050565b6060600080856001600160a01b03168560405161067a919061097d565b600060405180830381855af49150503d80600081146106b5576040519150601f19603f3d011682016040523d82523d6000602084013e6106ba565b606091505b50915091506106cb868383876106d5565b9695505050505050565b6060831561074457825160000361073d576001600160a01b0385163b61073d5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000006044820152606401610173565b508161074e565b61074e8383610756565b949350505050565b8151156107665781518083602001fd5b8060405162461bcd60e51b81526004016101739190610999565b80356001600160a01b038116811461079757600080fd5b919050565b6000602082840312156107ae57600080fd5b61065682610780565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156107e057600080fd5b6107e983610780565b9150602083013567ffffffffffffffff8082111561080657600080fd5b818501915085601f83011261081a57600080fd5b81358181111561082c5761082c6107b7565b604051601f8201601f19908116603f01168101908382118183101715610854576108546107b7565b8160405282815288602084870101111561086d57600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156108a157600080fd5b5035919050565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b60006020828403121561095257600080fd5b5051919050565b60005b8381101561097457818101518382015260200161095c565b50506000910152565b6000825161098f818460208701610959565b9190910192915050565b60208152600082518060208401526109b8816040850160208701610959565b601f01601f1916919091016040019291505056fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a264697066735822122056bcbc13ad9be7dedf44aee9c85447e722414666fb9fdba2b2f1b328be75039e64736f6c63430008150033\",\"deployedBytecode\":\"0x6080604052600436106100705760003560e01c806352d1902d1161004e57806352d1902d146100cd57806354fd4d50146100e25780636057361d146100f6578063a9cc47181461011657600080fd5b80632e64cec1146100755780633659cfe6146100985780634f1ef286146100ba575b600080fd5b34801561008157600080fd5b506000545b60405190815260200160405180910390f35b3480156100a457600080fd5b506100b86100b336600461079c565b61012b565b005b6100b86100c83660046107cd565b61020a565b3480156100d957600080fd5b506100866102d1565b3480156100ee57600080fd5b506001610086565b34801561010257600080fd5b506100b861011136600461088f565b600055565b34801561012257600080fd5b506100b8610384565b6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016300361017c5760405162461bcd60e51b8152600401610173906108a8565b60405180910390fd5b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166101c56000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146101eb5760405162461bcd60e51b8152600401610173906108f4565b60408051600080825260208201909252610207918391906103ba565b50565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036102525760405162461bcd60e51b8152600401610173906108a8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661029b6000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146102c15760405162461bcd60e51b8152600401610173906108f4565b6102cd828260016103ba565b5050565b6000306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103715760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c00000000000000006064820152608401610173565b506000805160206109cd83398151915290565b60405162461bcd60e51b815260206004820152600b60248201526a109bde0e8819985a5b195960aa1b6044820152606401610173565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff16156103f2576103ed8361052a565b505050565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa92505050801561044c575060408051601f3d908101601f1916820190925261044991810190610940565b60015b6104af5760405162461bcd60e51b815260206004820152602e60248201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960448201526d6f6e206973206e6f74205555505360901b6064820152608401610173565b6000805160206109cd833981519152811461051e5760405162461bcd60e51b815260206004820152602960248201527f45524331393637557067726164653a20756e737570706f727465642070726f786044820152681a58589b195555525160ba1b6064820152608401610173565b506103ed8383836105c6565b6001600160a01b0381163b6105975760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610173565b6000805160206109cd83398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b6105cf836105f1565b6000825111806105dc5750805b156103ed576105eb8383610631565b50505050565b6105fa8161052a565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b606061065683836040518060600160405280602781526020016109ed6027913961065d565b9392505050565b6060600080856001600160a01b03168560405161067a919061097d565b600060405180830381855af49150503d80600081146106b5576040519150601f19603f3d011682016040523d82523d6000602084013e6106ba565b606091505b50915091506106cb868383876106d5565b9695505050505050565b6060831561074457825160000361073d576001600160a01b0385163b61073d5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000006044820152606401610173565b508161074e565b61074e8383610756565b949350505050565b8151156107665781518083602001fd5b8060405162461bcd60e51b81526004016101739190610999565b80356001600160a01b038116811461079757600080fd5b919050565b6000602082840312156107ae57600080fd5b61065682610780565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156107e057600080fd5b6107e983610780565b9150602083013567ffffffffffffffff8082111561080657600080fd5b818501915085601f83011261081a57600080fd5b81358181111561082c5761082c6107b7565b604051601f8201601f19908116603f01168101908382118183101715610854576108546107b7565b8160405282815288602084870101111561086d57600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156108a157600080fd5b5035919050565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b60006020828403121561095257600080fd5b5051919050565b60005b8381101561097457818101518382015260200161095c565b50506000910152565b6000825161098f818460208701610959565b9190910192915050565b60208152600082518060208401526109b8816040850160208701610959565b601f01601f1916919091016040019291505056fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a264697066735822122056bcbc13ad9be7dedf44aee9c85447e722414666fb9fdba2b2f1b328be75039e64736f6c63430008150033\",\"linkReferences\":{},\"deployedLinkReferences\":{},\"immutableReferences\":{\"2396\":[{\"length\":32,\"start\":309},{\"length\":32,\"start\":382},{\"length\":32,\"start\":532},{\"length\":32,\"start\":596},{\"length\":32,\"start\":734}]},\"compiler\":{\"version\":\"0.8.21+commit.d9974bed\"},\"metadata\":\"{\\\"compiler\\\":{\\\"version\\\":\\\"0.8.21+commit.d9974bed\\\"},\\\"language\\\":\\\"Solidity\\\",\\\"output\\\":{\\\"abi\\\":[{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":false,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"previousAdmin\\\",\\\"type\\\":\\\"address\\\"},{\\\"indexed\\\":false,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newAdmin\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"AdminChanged\\\",\\\"type\\\":\\\"event\\\"},{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":true,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"beacon\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"BeaconUpgraded\\\",\\\"type\\\":\\\"event\\\"},{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":true,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"implementation\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"Upgraded\\\",\\\"type\\\":\\\"event\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"fail\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"pure\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"proxiableUUID\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"bytes32\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"bytes32\\\"}],\\\"stateMutability\\\":\\\"view\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"retrieve\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"stateMutability\\\":\\\"view\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"value\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"name\\\":\\\"store\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"nonpayable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newImplementation\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"upgradeTo\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"nonpayable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newImplementation\\\",\\\"type\\\":\\\"address\\\"},{\\\"internalType\\\":\\\"bytes\\\",\\\"name\\\":\\\"data\\\",\\\"type\\\":\\\"bytes\\\"}],\\\"name\\\":\\\"upgradeToAndCall\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"payable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"version\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"stateMutability\\\":\\\"pure\\\",\\\"type\\\":\\\"function\\\"}],\\\"devdoc\\\":{\\\"details\\\":\\\"Implementation deployed behind the proxies in the proxy tests. Anyone may upgrade a UUPS proxy pointing at it.\\\",\\\"events\\\":{\\\"AdminChanged(address,address)\\\":{\\\"details\\\":\\\"Emitted when the admin account has changed.\\\"},\\\"BeaconUpgraded(address)\\\":{\\\"details\\\":\\\"Emitted when the beacon is changed.\\\"},\\\"Upgraded(address)\\\":{\\\"details\\\":\\\"Emitted when the implementation is upgraded.\\\"}},\\\"kind\\\":\\\"dev\\\",\\\"methods\\\":{\\\"proxiableUUID()\\\":{\\\"details\\\":\\\"Implementation of the ERC1822 {proxiableUUID} function. This returns the storage slot used by the implementation. It is used to validate the implementation's compatibility when performing an upgrade. IMPORTANT: A proxy pointing at a proxiable contract should not be considered proxiable itself, because this risks bricking a proxy that upgrades to it, by delegating to itself until out of gas. Thus it is critical that this function revert if invoked through a proxy. This is guaranteed by the `notDelegated` modifier.\\\"},\\\"upgradeTo(address)\\\":{\\\"custom:oz-upgrades-unsafe-allow-reachable\\\":\\\"delegatecall\\\",\\\"details\\\":\\\"Upgrade the implementation of the proxy to `newImplementation`. Calls {_authorizeUpgrade}. Emits an {Upgraded} event.\\\"},\\\"upgradeToAndCall(address,bytes)\\\":{\\\"custom:oz-upgrades-unsafe-allow-reachable\\\":\\\"delegatecall\\\",\\\"details\\\":\\\"Upgrade the implementation of the proxy to `newImplementation`, and subsequently execute the function call encoded in `data`. Calls {_authorizeUpgrade}. Emits an {Upgraded} event.\\\"}},\\\"version\\\":1},\\\"userdoc\\\":{\\\"kind\\\":\\\"user\\\",\\\"methods\\\":{},\\\"version\\\":1}},\\\"settings\\\":{\\\"compilationTarget\\\":{\\\"test/Box.sol\\\":\\\"Box\\\"},\\\"evmVersion\\\":\\\"istanbul\\\",\\\"libraries\\\":{},\\\"metadata\\\":{\\\"bytecodeHash\\\":\\\"ipfs\\\"},\\\"optimizer\\\":{\\\"enabled\\\":true,\\\"runs\\\":200},\\\"remappings\\\":[]},\\\"sources\\\":{\\\"@openzeppelin/contracts/interfaces/IERC1967.sol\\\":{\\\"keccak256\\\":\\\"0x3cbef5ebc24b415252e2f8c0c9254555d30d9f085603b4b80d9b5ed20ab87e90\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://e8fa670c3bdce78e642cc6ae11c4cb38b133499cdce5e1990a9979d424703263\\\",\\\"dweb:/ipfs/QmVxeCUk4jL2pXQyhsoNJwyU874wRufS2WvGe8TgPKPqhE\\\"]},\\\"@openzeppelin/contracts/interfaces/draft-IERC1822.sol\\\":{\\\"keccak256\\\":\\\"0x1d4afe6cb24200cc4545eed814ecf5847277dfe5d613a1707aad5fceecebcfff\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://383fb7b8181016ac5ccf07bc9cdb7c1b5045ea36e2cc4df52bcbf20396fc7688\\\",\\\"dweb:/ipfs/QmYJ7Cg4WmE3rR8KGQxjUCXFfTH6TcwZ2Z1f6tPrq7jHFr\\\"]},\\\"@openzeppelin/contracts/proxy/ERC1967/ERC1967Upgrade.sol\\\":{\\\"keccak256\\\":\\\"0x3b21ae06bf5957f73fa16754b0669c77b7abd8ba6c072d35c3281d446fdb86c2\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://2db8e18505e86e02526847005d7287a33e397ed7fb9eaba3fd4a4a197add16e2\\\",\\\"dweb:/ipfs/QmW9BSuKTzHWHBNSHF4L8XfVuU1uJrP2vLg84YtBd8mL82\\\"]},\\\"@openzeppelin/contracts/proxy/beacon/IBeacon.sol\\\":{\\\"keccak256\\\":\\\"0xd50a3421ac379ccb1be435fa646d66a65c986b4924f0849839f08692f39dde61\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://ada1e030c0231db8d143b44ce92b4d1158eedb087880cad6d8cc7bd7ebe7b354\\\",\\\"dweb:/ipfs/QmWZ2NHZweRpz1U9GF6R1h65ri76dnX7fNxLBeM2t5N5Ce\\\"]},\\\"@openzeppelin/contracts/proxy/utils/UUPSUpgradeable.sol\\\":{\\\"keccak256\\\":\\\"0xc6619957bcc6641fe8984bfaf9ff11a9e4b97d8149c0495f608f9a2416d7c5cf\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://543be67f7fa43b1b932637c1c7f12035f0f4b0f7ee2bd3c33841186f79c165c1\\\",\\\"dweb:/ipfs/QmSBPM2UVKbmJqWfD9i6hSiqbaE8TV4TSqfuiivziRRLKM\\\"]},\\\"@openzeppelin/contracts/utils/Address.sol\\\":{\\\"keccak256\\\":\\\"0x006dd67219697fe68d7fbfdea512e7c4cb64a43565ed86171d67e844982da6fa\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://2455248c8ddd9cc6a7af76a13973cddf222072427e7b0e2a7d1aff345145e931\\\",\\\"dweb:/ipfs/QmfYjnjRbWqYpuxurqveE6HtzsY1Xx323J428AKQgtBJZm\\\"]},\\\"@openzeppelin/contracts/utils/StorageSlot.sol\\\":{\\\"keccak256\\\":\\\"0xf09e68aa0dc6722a25bc46490e8d48ed864466d17313b8a0b254c36b54e49899\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://e26daf81e2252dc1fe1ce0e4b55c2eb7c6d1ee84ae6558d1a9554432ea1d32da\\\",\\\"dweb:/ipfs/Qmb1UANWiWq5pCKbmHSu772hd4nt374dVaghGmwSVNuk8Q\\\"]},\\\"test/Box.sol\\\":{\\\"keccak256\\\":\\\"0xf95afe6c03068776340060afcdf8394aeee289adbe2a8a7cea1d6317e0ab9bbd\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://a29e6e609c67b101a4fcd56f82190c8b79d1d8b81361cd00e2a114a7f6ef4fba\\\",\\\"dweb:/ipfs/QmUM1TZf6NxYEx7m2DysGk8sbzywgSRXwBBESuAHtsnw3n\\\"]}},\\\"version\\\":1}\",\"storageLayout\":{\"storage\":[{\"astId\":7804,\"contract\":\"test/Box.sol:Box\",\"label\":\"_value\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_uint256\"}],\"types\":{\"t_uint256\":{\"encoding\":\"inplace\",\"label\":\"uint256\",\"numberOfBytes\":\"32\"}}}}"
+
+// boxV2Artifact is the artifact of BoxV2 in test/Box.sol.
+//
+// Second version of {Box}, telling the tests which implementation a proxy
+// points at.
+const boxV2Artifact = "{\"contractName\":\"BoxV2\",\"sourceName\":\"test/Box.sol\",\"abi\":[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"fail\",\"outputs\":[],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"retrieve\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"store\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}],\"bytecode\":\"0x60a06040523060805234801561001457600080fd5b50608051610a4961004c600039600081816101350152818161017e015281816102140152818161025401526102de0152610a496000f3fe6080604052600436106100705760003560e01c806352d1902d1161004e57806352d1902d146100cd57806354fd4d50146100e25780636057361d146100f6578063a9cc47181461011657600080fd5b80632e64cec1146100755780633659cfe6146100985780634f1ef286146100ba575b600080fd5b34801561008157600080fd5b506000545b60405190815260200160405180910390f35b3480156100a457600080fd5b506100b86100b336600461079c565b61012b565b005b6100b86100c83660046107cd565b61020a565b3480156100d957600080fd5b506100866102d1565b3480156100ee57600080fd5b506002610086565b34801561010257600080fd5b506100b861011136600461088f565b600055565b34801561012257600080fd5b506100b8610384565b6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016300361017c5760405162461bcd60e51b8152600401610173906108a8565b60405180910390fd5b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166101c56000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146101eb5760405162461bcd60e51b8152600401610173906108f4565b60408051600080825260208201909252610207918391906103ba565b50565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036102525760405162461bcd60e51b8152600401610173906108a8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661029b6000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146102c15760405162461bcd60e51b8152600401610173906108f4565b6102cd828260016103ba565b5050565b6000306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103715760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c00000000000000006064820152608401610173565b506000805160206109cd83398151915290565b60405162461bcd60e51b815260206004820152600b60248201526a109bde0e8819985a5b195960aa1b6044820152606401610173565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff16156103f2576103ed8361052a565b505050565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa92505050801561044c575060408051601f3d908101601f1916820190925261044991810190610940565b60015b6104af5760405162461bcd60e51b815260206004820152602e60248201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960448201526d6f6e206973206e6f74205555505360901b6064820152608401610173565b6000805160206109cd833981519152811461051e5760405162461bcd60e51b815260206004820152602960248201527f45524331393637557067726164653a20756e737570706f727465642070726f786044820152681a58589b195555525160ba1b6064820152608401610173565b506103ed8383836105c6565b6001600160a01b0381163b6105975760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610173565b6000805160206109cd83398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b6105cf836105f1565b6000825111806105dc5750805b156103ed576105eb8383610631565b50505050565b6105fa8161052a565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b606061065683836040518060600160405280602781526020016109ed6027913961065d565b9392505050565b6060600080856001600160a01b03168560405161067a919061097d565b600060405180830381855af49150503d80600081146106b5576040519150601f19603f3d011682016040523d82523d6000602084013e6106ba565b606091505b50915091506106cb868383876106d5565b9695505050505050565b6060831561074457825160000361073d576001600160a01b0385163b61073d5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000006044820152606401610173565b508161074e565b61074e8383610756565b949350505050565b8151156107665781518083602001fd5b8060405162461bcd60e51b81526004016101739190610999565b80356001600160a01b038116811461079757600080fd5b919050565b6000602082840312156107ae57600080fd5b61065682610780565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156107e057600080fd5b6107e983610780565b9150602083013567ffffffffffffffff8082111561080657600080fd5b818501915085601f83011261081a57600080fd5b81358181111561082c5761082c6107b7565b604051601f8201601f19908116603f01168101908382118183101715610854576108546107b7565b8160405282815288602084870101111561086d57600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156108a157600080fd5b5035919050565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b60006020828403121561095257600080fd5b5051919050565b60005b8381101561097457818101518382015260200161095c565b50506000910152565b6000825161098f818460208701610959565b9190910192915050565b60208152600082518060208401526109b8816040850160208701610959565b601f01601f1916919091016040019291505056fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a26469706673582212203c2b8e818a54ec4137e59c26be35193d5ce737af103182209822df330fcdbe5d64736f6c63430008150033\",\"deployedBytecode\":\"0x6080604052600436106100705760003560e01c806352d1902d1161004e57806352d1902d146100cd57806354fd4d50146100e25780636057361d146100f6578063a9cc47181461011657600080fd5b80632e64cec1146100755780633659cfe6146100985780634f1ef286146100ba575b600080fd5b34801561008157600080fd5b506000545b60405190815260200160405180910390f35b3480156100a457600080fd5b506100b86100b336600461079c565b61012b565b005b6100b86100c83660046107cd565b61020a565b3480156100d957600080fd5b506100866102d1565b3480156100ee57600080fd5b506002610086565b34801561010257600080fd5b506100b861011136600461088f565b600055565b34801561012257600080fd5b506100b8610384565b6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016300361017c5760405162461bcd60e51b8152600401610173906108a8565b60405180910390fd5b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166101c56000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146101eb5760405162461bcd60e51b8152600401610173906108f4565b60408051600080825260208201909252610207918391906103ba565b50565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036102525760405162461bcd60e51b8152600401610173906108a8565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661029b6000805160206109cd833981519152546001600160a01b031690565b6001600160a01b0316146102c15760405162461bcd60e51b8152600401610173906108f4565b6102cd828260016103ba565b5050565b6000306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103715760405162461bcd60e51b815260206004820152603860248201527f555550535570677261646561626c653a206d757374206e6f742062652063616c60448201527f6c6564207468726f7567682064656c656761746563616c6c00000000000000006064820152608401610173565b506000805160206109cd83398151915290565b60405162461bcd60e51b815260206004820152600b60248201526a109bde0e8819985a5b195960aa1b6044820152606401610173565b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd91435460ff16156103f2576103ed8361052a565b505050565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa92505050801561044c575060408051601f3d908101601f1916820190925261044991810190610940565b60015b6104af5760405162461bcd60e51b815260206004820152602e60248201527f45524331393637557067726164653a206e657720696d706c656d656e7461746960448201526d6f6e206973206e6f74205555505360901b6064820152608401610173565b6000805160206109cd833981519152811461051e5760405162461bcd60e51b815260206004820152602960248201527f45524331393637557067726164653a20756e737570706f727465642070726f786044820152681a58589b195555525160ba1b6064820152608401610173565b506103ed8383836105c6565b6001600160a01b0381163b6105975760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610173565b6000805160206109cd83398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b6105cf836105f1565b6000825111806105dc5750805b156103ed576105eb8383610631565b50505050565b6105fa8161052a565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b606061065683836040518060600160405280602781526020016109ed6027913961065d565b9392505050565b6060600080856001600160a01b03168560405161067a919061097d565b600060405180830381855af49150503d80600081146106b5576040519150601f19603f3d011682016040523d82523d6000602084013e6106ba565b606091505b50915091506106cb868383876106d5565b9695505050505050565b6060831561074457825160000361073d576001600160a01b0385163b61073d5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000006044820152606401610173565b508161074e565b61074e8383610756565b949350505050565b8151156107665781518083602001fd5b8060405162461bcd60e51b81526004016101739190610999565b80356001600160a01b038116811461079757600080fd5b919050565b6000602082840312156107ae57600080fd5b61065682610780565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156107e057600080fd5b6107e983610780565b9150602083013567ffffffffffffffff8082111561080657600080fd5b818501915085601f83011261081a57600080fd5b81358181111561082c5761082c6107b7565b604051601f8201601f19908116603f01168101908382118183101715610854576108546107b7565b8160405282815288602084870101111561086d57600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156108a157600080fd5b5035919050565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b60006020828403121561095257600080fd5b5051919050565b60005b8381101561097457818101518382015260200161095c565b50506000910152565b6000825161098f818460208701610959565b9190910192915050565b60208152600082518060208401526109b8816040850160208701610959565b601f01601f1916919091016040019291505056fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a26469706673582212203c2b8e818a54ec4137e59c26be35193d5ce737af103182209822df330fcdbe5d64736f6c63430008150033\",\"linkReferences\":{},\"deployedLinkReferences\":{},\"immutableReferences\":{\"2396\":[{\"length\":32,\"start\":309},{\"length\":32,\"start\":382},{\"length\":32,\"start\":532},{\"length\":32,\"start\":596},{\"length\":32,\"start\":734}]},\"compiler\":{\"version\":\"0.8.21+commit.d9974bed\"},\"metadata\":\"{\\\"compiler\\\":{\\\"version\\\":\\\"0.8.21+commit.d9974bed\\\"},\\\"language\\\":\\\"Solidity\\\",\\\"output\\\":{\\\"abi\\\":[{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":false,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"previousAdmin\\\",\\\"type\\\":\\\"address\\\"},{\\\"indexed\\\":false,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newAdmin\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"AdminChanged\\\",\\\"type\\\":\\\"event\\\"},{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":true,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"beacon\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"BeaconUpgraded\\\",\\\"type\\\":\\\"event\\\"},{\\\"anonymous\\\":false,\\\"inputs\\\":[{\\\"indexed\\\":true,\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"implementation\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"Upgraded\\\",\\\"type\\\":\\\"event\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"fail\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"pure\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"proxiableUUID\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"bytes32\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"bytes32\\\"}],\\\"stateMutability\\\":\\\"view\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"retrieve\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"stateMutability\\\":\\\"view\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"value\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"name\\\":\\\"store\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"nonpayable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newImplementation\\\",\\\"type\\\":\\\"address\\\"}],\\\"name\\\":\\\"upgradeTo\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"nonpayable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[{\\\"internalType\\\":\\\"address\\\",\\\"name\\\":\\\"newImplementation\\\",\\\"type\\\":\\\"address\\\"},{\\\"internalType\\\":\\\"bytes\\\",\\\"name\\\":\\\"data\\\",\\\"type\\\":\\\"bytes\\\"}],\\\"name\\\":\\\"upgradeToAndCall\\\",\\\"outputs\\\":[],\\\"stateMutability\\\":\\\"payable\\\",\\\"type\\\":\\\"function\\\"},{\\\"inputs\\\":[],\\\"name\\\":\\\"version\\\",\\\"outputs\\\":[{\\\"internalType\\\":\\\"uint256\\\",\\\"name\\\":\\\"\\\",\\\"type\\\":\\\"uint256\\\"}],\\\"stateMutability\\\":\\\"pure\\\",\\\"type\\\":\\\"function\\\"}],\\\"devdoc\\\":{\\\"details\\\":\\\"Second version of {Box}, telling the tests which implementation a proxy points at.\\\",\\\"events\\\":{\\\"AdminChanged(address,address)\\\":{\\\"details\\\":\\\"Emitted when the admin account has changed.\\\"},\\\"BeaconUpgraded(address)\\\":{\\\"details\\\":\\\"Emitted when the beacon is changed.\\\"},\\\"Upgraded(address)\\\":{\\\"details\\\":\\\"Emitted when the implementation is upgraded.\\\"}},\\\"kind\\\":\\\"dev\\\",\\\"methods\\\":{\\\"proxiableUUID()\\\":{\\\"details\\\":\\\"Implementation of the ERC1822 {proxiableUUID} function. This returns the storage slot used by the implementation. It is used to validate the implementation's compatibility when performing an upgrade. IMPORTANT: A proxy pointing at a proxiable contract should not be considered proxiable itself, because this risks bricking a proxy that upgrades to it, by delegating to itself until out of gas. Thus it is critical that this function revert if invoked through a proxy. This is guaranteed by the `notDelegated` modifier.\\\"},\\\"upgradeTo(address)\\\":{\\\"custom:oz-upgrades-unsafe-allow-reachable\\\":\\\"delegatecall\\\",\\\"details\\\":\\\"Upgrade the implementation of the proxy to `newImplementation`. Calls {_authorizeUpgrade}. Emits an {Upgraded} event.\\\"},\\\"upgradeToAndCall(address,bytes)\\\":{\\\"custom:oz-upgrades-unsafe-allow-reachable\\\":\\\"delegatecall\\\",\\\"details\\\":\\\"Upgrade the implementation of the proxy to `newImplementation`, and subsequently execute the function call encoded in `data`. Calls {_authorizeUpgrade}. Emits an {Upgraded} event.\\\"}},\\\"version\\\":1},\\\"userdoc\\\":{\\\"kind\\\":\\\"user\\\",\\\"methods\\\":{},\\\"version\\\":1}},\\\"settings\\\":{\\\"compilationTarget\\\":{\\\"test/Box.sol\\\":\\\"BoxV2\\\"},\\\"evmVersion\\\":\\\"istanbul\\\",\\\"libraries\\\":{},\\\"metadata\\\":{\\\"bytecodeHash\\\":\\\"ipfs\\\"},\\\"optimizer\\\":{\\\"enabled\\\":true,\\\"runs\\\":200},\\\"remappings\\\":[]},\\\"sources\\\":{\\\"@openzeppelin/contracts/interfaces/IERC1967.sol\\\":{\\\"keccak256\\\":\\\"0x3cbef5ebc24b415252e2f8c0c9254555d30d9f085603b4b80d9b5ed20ab87e90\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://e8fa670c3bdce78e642cc6ae11c4cb38b133499cdce5e1990a9979d424703263\\\",\\\"dweb:/ipfs/QmVxeCUk4jL2pXQyhsoNJwyU874wRufS2WvGe8TgPKPqhE\\\"]},\\\"@openzeppelin/contracts/interfaces/draft-IERC1822.sol\\\":{\\\"keccak256\\\":\\\"0x1d4afe6cb24200cc4545eed814ecf5847277dfe5d613a1707aad5fceecebcfff\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://383fb7b8181016ac5ccf07bc9cdb7c1b5045ea36e2cc4df52bcbf20396fc7688\\\",\\\"dweb:/ipfs/QmYJ7Cg4WmE3rR8KGQxjUCXFfTH6TcwZ2Z1f6tPrq7jHFr\\\"]},\\\"@openzeppelin/contracts/proxy/ERC1967/ERC1967Upgrade.sol\\\":{\\\"keccak256\\\":\\\"0x3b21ae06bf5957f73fa16754b0669c77b7abd8ba6c072d35c3281d446fdb86c2\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://2db8e18505e86e02526847005d7287a33e397ed7fb9eaba3fd4a4a197add16e2\\\",\\\"dweb:/ipfs/QmW9BSuKTzHWHBNSHF4L8XfVuU1uJrP2vLg84YtBd8mL82\\\"]},\\\"@openzeppelin/contracts/proxy/beacon/IBeacon.sol\\\":{\\\"keccak256\\\":\\\"0xd50a3421ac379ccb1be435fa646d66a65c986b4924f0849839f08692f39dde61\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://ada1e030c0231db8d143b44ce92b4d1158eedb087880cad6d8cc7bd7ebe7b354\\\",\\\"dweb:/ipfs/QmWZ2NHZweRpz1U9GF6R1h65ri76dnX7fNxLBeM2t5N5Ce\\\"]},\\\"@openzeppelin/contracts/proxy/utils/UUPSUpgradeable.sol\\\":{\\\"keccak256\\\":\\\"0xc6619957bcc6641fe8984bfaf9ff11a9e4b97d8149c0495f608f9a2416d7c5cf\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://543be67f7fa43b1b932637c1c7f12035f0f4b0f7ee2bd3c33841186f79c165c1\\\",\\\"dweb:/ipfs/QmSBPM2UVKbmJqWfD9i6hSiqbaE8TV4TSqfuiivziRRLKM\\\"]},\\\"@openzeppelin/contracts/utils/Address.sol\\\":{\\\"keccak256\\\":\\\"0x006dd67219697fe68d7fbfdea512e7c4cb64a43565ed86171d67e844982da6fa\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://2455248c8ddd9cc6a7af76a13973cddf222072427e7b0e2a7d1aff345145e931\\\",\\\"dweb:/ipfs/QmfYjnjRbWqYpuxurqveE6HtzsY1Xx323J428AKQgtBJZm\\\"]},\\\"@openzeppelin/contracts/utils/StorageSlot.sol\\\":{\\\"keccak256\\\":\\\"0xf09e68aa0dc6722a25bc46490e8d48ed864466d17313b8a0b254c36b54e49899\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://e26daf81e2252dc1fe1ce0e4b55c2eb7c6d1ee84ae6558d1a9554432ea1d32da\\\",\\\"dweb:/ipfs/Qmb1UANWiWq5pCKbmHSu772hd4nt374dVaghGmwSVNuk8Q\\\"]},\\\"test/Box.sol\\\":{\\\"keccak256\\\":\\\"0xf95afe6c03068776340060afcdf8394aeee289adbe2a8a7cea1d6317e0ab9bbd\\\",\\\"license\\\":\\\"MIT\\\",\\\"urls\\\":[\\\"bzz-raw://a29e6e609c67b101a4fcd56f82190c8b79d1d8b81361cd00e2a114a7f6ef4fba\\\",\\\"dweb:/ipfs/QmUM1TZf6NxYEx7m2DysGk8sbzywgSRXwBBESuAHtsnw3n\\\"]}},\\\"version\\\":1}\",\"storageLayout\":{\"storage\":[{\"astId\":7804,\"contract\":\"test/Box.sol:BoxV2\",\"label\":\"_value\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_uint256\"}],\"types\":{\"t_uint256\":{\"encoding\":\"inplace\",\"label\":\"uint256\",\"numberOfBytes\":\"32\"}}}}"
@@ -0,0 +1,364 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mislavio/contracter/testchain"
+)
+
+var (
+	parsedBox   = mustParseArtifact(boxArtifact)
+	parsedBoxV2 = mustParseArtifact(boxV2Artifact)
+)
+
+var (
+	boxABI        = string(parsedBox.ABI)
+	proxyAdminABI = string(ProxyAdminArtifact().ABI)
+)
+
+// Accounts of the proxy test chains.
+const (
+	proxyWallet = 0
+	proxyOther  = 1
+)
+
+var parsedBoxABI, _ = abi.JSON(strings.NewReader(boxABI))
+
+// deployCode deploys code from the wallet and reports whether it
+// succeeded.
+func deployCode(c *testchain.Chain, code []byte) (common.Address, bool) {
+	address, receipt := c.Deploy(proxyWallet, 1000000, code)
+	return address, receipt.Status == types.ReceiptStatusSuccessful
+}
+
+// sendData sends data from account from to to and reports whether the
+// transaction succeeded.
+func sendData(c *testchain.Chain, from int, to common.Address, data []byte) bool {
+	return c.Send(from, 1000000, to, nil, data).Status == types.ReceiptStatusSuccessful
+}
+
+// callOutput returns the output of a call, the revert data if it reverts.
+func callOutput(t *testing.T, c *testchain.Chain, from common.Address, to common.Address, data []byte) []byte {
+	out, err := c.CallContract(context.Background(), ethereum.CallMsg{From: from, To: &to, Data: data}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// boxNumber calls a box method returning uint256 through to from the
+// wallet.
+func boxNumber(t *testing.T, c *testchain.Chain, to common.Address, method string) int64 {
+	out := callOutput(t, c, c.Address(proxyWallet), to, mustPack(t, parsedBoxABI, method))
+	if len(out) != 32 {
+		t.Fatalf("%v() returned %x", method, out)
+	}
+	return new(big.Int).SetBytes(out).Int64()
+}
+
+func callAddress(t *testing.T, c *testchain.Chain, from common.Address, to common.Address, data []byte) common.Address {
+	out := callOutput(t, c, from, to, data)
+	if len(out) != 32 {
+		t.Fatalf("call returned %x", out)
+	}
+	return common.BytesToAddress(out)
+}
+
+func slotAddress(t *testing.T, c *testchain.Chain, address common.Address, slot common.Hash) common.Address {
+	v, err := c.StorageAt(context.Background(), address, slot, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return common.BytesToAddress(v)
+}
+
+func mustPack(t *testing.T, parsed abi.ABI, method string, args ...interface{}) []byte {
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func mustInitCode(t *testing.T, kind string, implementation common.Address, admin common.Address, initData []byte) []byte {
+	code, err := ProxyInitCode(kind, implementation, admin, initData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func mustUpgradeCalldata(t *testing.T, proxy common.Address, implementation common.Address, initData []byte) []byte {
+	data, err := ProxyAdminUpgradeCalldata(proxy, implementation, initData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestProxyArtifacts(t *testing.T) {
+	for _, a := range []*Artifact{transparentProxy, erc1967Proxy, ProxyAdminArtifact()} {
+		if a.CompilerVersion != "0.8.21+commit.d9974bed" || len(a.Metadata) == 0 {
+			t.Errorf("%v: compiler %q, %d bytes of metadata", a.Name, a.CompilerVersion, len(a.Metadata))
+		}
+		// The metadata hash in the runtime code matches the metadata.
+		if _, err := NewContractFromArtifact(a); err != nil {
+			t.Errorf("NewContractFromArtifact(%v) error = %v", a.Name, err)
+		}
+	}
+}
+
+func TestTransparentProxy(t *testing.T) {
+	c := testchain.New(t, 8000000, 2, nil)
+
+	box, ok := deployCode(c, parsedBox.Bytecode)
+	boxV2, ok2 := deployCode(c, parsedBoxV2.Bytecode)
+	admin, ok3 := deployCode(c, ProxyAdminArtifact().Bytecode)
+	if !ok || !ok2 || !ok3 {
+		t.Fatal("deployment failed")
+	}
+	owner, err := ProxyAdminOwner(context.Background(), c, admin)
+	if err != nil || owner != c.Address(proxyWallet) {
+		t.Fatalf("ProxyAdminOwner() = %v, %v, want %v", owner.Hex(), err, c.Address(proxyWallet).Hex())
+	}
+
+	// The constructor delegatecalls the initializer.
+	proxy, ok := deployCode(c, mustInitCode(t, ProxyTransparent, box, admin, mustPack(t, parsedBoxABI, "store", big.NewInt(42))))
+	if !ok {
+		t.Fatal("proxy deployment failed")
+	}
+	if got := slotAddress(t, c, proxy, ImplementationSlot); got != box {
+		t.Errorf("implementation slot = %v, want %v", got.Hex(), box.Hex())
+	}
+	if got := slotAddress(t, c, proxy, AdminSlot); got != admin {
+		t.Errorf("admin slot = %v, want %v", got.Hex(), admin.Hex())
+	}
+
+	// Calls from the wallet, which is not the admin, are delegated.
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 42 {
+		t.Errorf("retrieve() = %v, want 42", got)
+	}
+	if !sendData(c, proxyWallet, proxy, mustPack(t, parsedBoxABI, "store", big.NewInt(43))) {
+		t.Fatal("store failed")
+	}
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 43 {
+		t.Errorf("retrieve() = %v, want 43", got)
+	}
+	if reason := DecodeRevert(boxABI, callOutput(t, c, c.Address(proxyWallet), proxy, mustPack(t, parsedBoxABI, "fail"))); reason == nil || reason.Message != "Box: failed" {
+		t.Errorf("fail() reverted with %v, want Box: failed", reason)
+	}
+
+	// admin() and implementation() answer the admin.
+	if got := callAddress(t, c, c.Address(proxyWallet), admin, mustPack(t, parsedProxyAdminABI, "getProxyImplementation", proxy)); got != box {
+		t.Errorf("getProxyImplementation() = %v, want %v", got.Hex(), box.Hex())
+	}
+	if got := callAddress(t, c, c.Address(proxyWallet), admin, mustPack(t, parsedProxyAdminABI, "getProxyAdmin", proxy)); got != admin {
+		t.Errorf("getProxyAdmin() = %v, want %v", got.Hex(), admin.Hex())
+	}
+
+	// Only the owner of the ProxyAdmin upgrades.
+	upgrade := mustUpgradeCalldata(t, proxy, boxV2, nil)
+	if reason := DecodeRevert(proxyAdminABI, callOutput(t, c, c.Address(proxyOther), admin, upgrade)); reason == nil || reason.Message != "Ownable: caller is not the owner" {
+		t.Errorf("upgrade by another account reverted with %v", reason)
+	}
+	if sendData(c, proxyOther, admin, upgrade) {
+		t.Error("upgrade by another account succeeded")
+	}
+
+	// upgradeTo keeps the storage.
+	if !sendData(c, proxyWallet, admin, upgrade) {
+		t.Fatal("upgrade failed")
+	}
+	if got := boxNumber(t, c, proxy, "version"); got != 2 {
+		t.Errorf("version() = %v, want 2", got)
+	}
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 43 {
+		t.Errorf("retrieve() = %v, want 43", got)
+	}
+
+	// upgradeToAndCall calls the new implementation and bubbles its revert.
+	failing := mustUpgradeCalldata(t, proxy, box, mustPack(t, parsedBoxABI, "fail"))
+	if reason := DecodeRevert(boxABI, callOutput(t, c, c.Address(proxyWallet), admin, failing)); reason == nil || reason.Message != "Box: failed" {
+		t.Errorf("upgradeAndCall reverted with %v, want Box: failed", reason)
+	}
+	if sendData(c, proxyWallet, admin, failing) {
+		t.Error("failing upgradeAndCall succeeded")
+	}
+	if !sendData(c, proxyWallet, admin, mustUpgradeCalldata(t, proxy, box, mustPack(t, parsedBoxABI, "store", big.NewInt(7)))) {
+		t.Fatal("upgradeAndCall failed")
+	}
+	if got := boxNumber(t, c, proxy, "version"); got != 1 {
+		t.Errorf("version() = %v, want 1", got)
+	}
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 7 {
+		t.Errorf("retrieve() = %v, want 7", got)
+	}
+
+	// The implementation must have code.
+	if sendData(c, proxyWallet, admin, mustUpgradeCalldata(t, proxy, c.Address(proxyOther), nil)) {
+		t.Error("upgrade to an address without code succeeded")
+	}
+
+	// changeAdmin hands the proxy over, the new admin reads and upgrades.
+	change, err := ChangeProxyAdminCalldata(proxy, c.Address(proxyOther))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sendData(c, proxyWallet, admin, change) {
+		t.Fatal("changeProxyAdmin failed")
+	}
+	if got := slotAddress(t, c, proxy, AdminSlot); got != c.Address(proxyOther) {
+		t.Errorf("admin slot = %v, want %v", got.Hex(), c.Address(proxyOther).Hex())
+	}
+	if got := callAddress(t, c, c.Address(proxyOther), proxy, mustPack(t, parsedProxyABI, "admin")); got != c.Address(proxyOther) {
+		t.Errorf("admin() = %v, want %v", got.Hex(), c.Address(proxyOther).Hex())
+	}
+	if !sendData(c, proxyOther, proxy, mustPack(t, parsedProxyABI, "upgradeTo", boxV2)) {
+		t.Fatal("upgradeTo by the new admin failed")
+	}
+	if got := callAddress(t, c, c.Address(proxyOther), proxy, mustPack(t, parsedProxyABI, "implementation")); got != boxV2 {
+		t.Errorf("implementation() = %v, want %v", got.Hex(), boxV2.Hex())
+	}
+	if sendData(c, proxyOther, proxy, mustPack(t, parsedProxyABI, "changeAdmin", common.Address{})) {
+		t.Error("changeAdmin to the zero address succeeded")
+	}
+}
+
+func TestUUPSProxy(t *testing.T) {
+	c := testchain.New(t, 8000000, 2, nil)
+
+	box, ok := deployCode(c, parsedBox.Bytecode)
+	boxV2, ok2 := deployCode(c, parsedBoxV2.Bytecode)
+	if !ok || !ok2 {
+		t.Fatal("deployment failed")
+	}
+
+	proxy, ok := deployCode(c, mustInitCode(t, ProxyUUPS, box, common.Address{}, mustPack(t, parsedBoxABI, "store", big.NewInt(5))))
+	if !ok {
+		t.Fatal("proxy deployment failed")
+	}
+	if got := slotAddress(t, c, proxy, ImplementationSlot); got != box {
+		t.Errorf("implementation slot = %v, want %v", got.Hex(), box.Hex())
+	}
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 5 {
+		t.Errorf("retrieve() = %v, want 5", got)
+	}
+
+	upgrade, err := UpgradeCalldata(boxV2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sendData(c, proxyWallet, proxy, upgrade) {
+		t.Fatal("upgradeTo failed")
+	}
+	if got := boxNumber(t, c, proxy, "version"); got != 2 {
+		t.Errorf("version() = %v, want 2", got)
+	}
+
+	upgrade, err = UpgradeCalldata(box, mustPack(t, parsedBoxABI, "store", big.NewInt(9)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sendData(c, proxyWallet, proxy, upgrade) {
+		t.Fatal("upgradeToAndCall failed")
+	}
+	if got := boxNumber(t, c, proxy, "version"); got != 1 {
+		t.Errorf("version() = %v, want 1", got)
+	}
+	if got := boxNumber(t, c, proxy, "retrieve"); got != 9 {
+		t.Errorf("retrieve() = %v, want 9", got)
+	}
+}
+
+func TestProxyConstructorReverts(t *testing.T) {
+	c := testchain.New(t, 8000000, 2, nil)
+
+	box, ok := deployCode(c, parsedBox.Bytecode)
+	admin, ok2 := deployCode(c, ProxyAdminArtifact().Bytecode)
+	if !ok || !ok2 {
+		t.Fatal("deployment failed")
+	}
+
+	tests := []struct {
+		name           string
+		kind           string
+		implementation common.Address
+		initData       []byte
+	}{
+		{"transparent without code", ProxyTransparent, c.Address(proxyOther), nil},
+		{"uups without code", ProxyUUPS, c.Address(proxyOther), nil},
+		{"transparent initializer reverts", ProxyTransparent, box, mustPack(t, parsedBoxABI, "fail")},
+		{"uups initializer reverts", ProxyUUPS, box, mustPack(t, parsedBoxABI, "fail")},
+	}
+	for _, tt := range tests {
+		if _, ok := deployCode(c, mustInitCode(t, tt.kind, tt.implementation, admin, tt.initData)); ok {
+			t.Errorf("%v: deployment succeeded", tt.name)
+		}
+	}
+}
+
+func TestProxyAdminOwnership(t *testing.T) {
+	c := testchain.New(t, 8000000, 2, nil)
+
+	admin, ok := deployCode(c, ProxyAdminArtifact().Bytecode)
+	if !ok {
+		t.Fatal("deployment failed")
+	}
+
+	transfer := mustPack(t, parsedProxyAdminABI, "transferOwnership", c.Address(proxyOther))
+	if sendData(c, proxyOther, admin, transfer) {
+		t.Error("transferOwnership by another account succeeded")
+	}
+	if sendData(c, proxyWallet, admin, mustPack(t, parsedProxyAdminABI, "transferOwnership", common.Address{})) {
+		t.Error("transferOwnership to the zero address succeeded")
+	}
+	if !sendData(c, proxyWallet, admin, transfer) {
+		t.Fatal("transferOwnership failed")
+	}
+	if owner, err := ProxyAdminOwner(context.Background(), c, admin); err != nil || owner != c.Address(proxyOther) {
+		t.Errorf("ProxyAdminOwner() = %v, %v, want %v", owner.Hex(), err, c.Address(proxyOther).Hex())
+	}
+	if !sendData(c, proxyOther, admin, mustPack(t, parsedProxyAdminABI, "renounceOwnership")) {
+		t.Fatal("renounceOwnership failed")
+	}
+	if owner, err := ProxyAdminOwner(context.Background(), c, admin); err != nil || owner != (common.Address{}) {
+		t.Errorf("ProxyAdminOwner() = %v, %v, want the zero address", owner.Hex(), err)
+	}
+
+	if _, err := ProxyAdminOwner(context.Background(), c, c.Address(proxyOther)); err == nil {
+		t.Error("ProxyAdminOwner() of an account without code succeeded")
+	}
+}
+
+func TestDecodeChangeProxyAdmin(t *testing.T) {
+	proxy := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	admin := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	change, _ := ChangeProxyAdminCalldata(proxy, admin)
+	upgrade, _ := ProxyAdminUpgradeCalldata(proxy, admin, nil)
+
+	tests := []struct {
+		name  string
+		data  []byte
+		proxy common.Address
+		admin common.Address
+		ok    bool
+	}{
+		{"changeProxyAdmin", change, proxy, admin, true},
+		{"upgrade", upgrade, common.Address{}, common.Address{}, false},
+		{"selector only", change[:4], common.Address{}, common.Address{}, false},
+		{"empty", nil, common.Address{}, common.Address{}, false},
+	}
+	for _, tt := range tests {
+		gotProxy, gotAdmin, ok := DecodeChangeProxyAdmin(tt.data)
+		if gotProxy != tt.proxy || gotAdmin != tt.admin || ok != tt.ok {
+			t.Errorf("DecodeChangeProxyAdmin(%v) = %v, %v, %v, want %v, %v, %v", tt.name, gotProxy.Hex(), gotAdmin.Hex(), ok, tt.proxy.Hex(), tt.admin.Hex(), tt.ok)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Revert kinds
+const (
+	RevertError   = "error"
+	RevertPanic   = "panic"
+	RevertCustom  = "custom"
+	RevertUnknown = "unknown"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// Solidity panic codes, see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicCodes = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// RevertReason represents a decoded revert payload.
+type RevertReason struct {
+	Kind    string        `json:"kind"`
+	Name    string        `json:"name,omitempty"`
+	Message string        `json:"message"`
+	Args    []interface{} `json:"args,omitempty"`
+	Data    string        `json:"data,omitempty"`
+}
+
+// String returns a human readable representation of the revert reason.
+func (r *RevertReason) String() string {
+	if r.Name == "" {
+		return r.Message
+	}
+	return fmt.Sprintf("%v: %v", r.Name, r.Message)
+}
+
+// abiError is a custom error definition from a contract ABI.
+type abiError struct {
+	Name   string
+	Inputs abi.Arguments
+}
+
+// DecodeRevert decodes revert data returned by a failing call using the
+// built in Error(string) and Panic(uint256) types and the custom errors
+// declared in abiJSON.
+func DecodeRevert(abiJSON string, data []byte) *RevertReason {
+	reason := &RevertReason{Kind: RevertUnknown, Data: hexutil.Encode(data)}
+
+	if len(data) < 4 {
+		reason.Message = "execution reverted without a reason"
+		return reason
+	}
+	selector, payload := data[:4], data[4:]
+
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		t, _ := abi.NewType("string", "", nil)
+		values, err := abi.Arguments{{Type: t}}.UnpackValues(payload)
+		if err == nil {
+			reason.Kind = RevertError
+			reason.Name = "Error"
+			reason.Message = values[0].(string)
+			return reason
+		}
+	case bytes.Equal(selector, panicSelector):
+		t, _ := abi.NewType("uint256", "", nil)
+		values, err := abi.Arguments{{Type: t}}.UnpackValues(payload)
+		if err == nil {
+			code := values[0].(*big.Int)
+			reason.Kind = RevertPanic
+			reason.Name = "Panic"
+			reason.Args = []interface{}{code}
+			reason.Message = panicCodes[code.Uint64()]
+			if !code.IsUint64() || reason.Message == "" {
+				reason.Message = "unknown panic code " + hexutil.EncodeBig(code)
+			}
+			return reason
+		}
+	default:
+		errs, err := parseABIErrors(abiJSON)
+		if err != nil {
+			break
+		}
+		if e, ok := errs[string(selector)]; ok {
+			values, err := e.Inputs.UnpackValues(payload)
+			if err == nil {
+				reason.Kind = RevertCustom
+				reason.Name = e.Name
+				reason.Args = values
+				reason.Message = formatErrorArgs(e, values)
+				return reason
+			}
+		}
+	}
+
+	reason.Message = "unrecognised revert data"
+	return reason
+}
+
+// RevertFromError extracts a revert reason from a node error. The revert
+// data nodes put in the data field of JSON-RPC errors is decoded with
+// abiJSON. Nodes without it only include the decoded Error(string)
+// message, e.g. "execution reverted: Ownable: caller is not the owner".
+func RevertFromError(err error, abiJSON string) (*RevertReason, bool) {
+	if err == nil {
+		return nil, false
+	}
+	if data, ok := errorRevertData(err); ok {
+		return DecodeRevert(abiJSON, data), true
+	}
+
+	msg := err.Error()
+	i := strings.Index(msg, "execution reverted")
+	if i < 0 {
+		return nil, false
+	}
+
+	reason := &RevertReason{Kind: RevertUnknown, Message: "execution reverted without a reason"}
+	if m := strings.TrimPrefix(msg[i:], "execution reverted"); strings.HasPrefix(m, ": ") {
+		reason.Kind = RevertError
+		reason.Name = "Error"
+		reason.Message = strings.TrimPrefix(m, ": ")
+	}
+	return reason, true
+}
+
+// errorRevertData returns the revert data in the data field of a JSON-RPC
+// error, a hex string which OpenEthereum prefixes with "Reverted ". The
+// go-ethereum client returns the error as an unexported type whose fields
+// are read by encoding it.
+func errorRevertData(err error) ([]byte, bool) {
+	var data interface{}
+	if e, ok := err.(interface{ ErrorData() interface{} }); ok {
+		data = e.ErrorData()
+	} else if b, jerr := json.Marshal(err); jerr == nil {
+		var fields struct {
+			Data interface{} `json:"data"`
+		}
+		if json.Unmarshal(b, &fields) == nil {
+			data = fields.Data
+		}
+	}
+
+	s, ok := data.(string)
+	if !ok {
+		return nil, false
+	}
+	b, herr := hexutil.Decode(strings.TrimPrefix(s, "Reverted "))
+	return b, herr == nil
+}
+
+// Call executes msg with eth_call at block, the latest if nil. It returns
+// the output of a call which succeeds and the reason of one which reverts,
+// decoded with abiJSON. Other errors, such as running out of gas,
+// insufficient funds or an unreachable node, are returned as they are.
+func Call(ctx context.Context, client *rpc.Client, msg ethereum.CallMsg, block *big.Int, abiJSON string) ([]byte, *RevertReason, error) {
+	out, err := callRPC(ctx, client, msg, block)
+	if err != nil {
+		if reason, ok := RevertFromError(err, abiJSON); ok {
+			return nil, reason, nil
+		}
+		return nil, nil, err
+	}
+	return out, nil, nil
+}
+
+// callRPC executes msg with eth_call like ethclient.Client.CallContract,
+// but returns the JSON-RPC error with its data.
+func callRPC(ctx context.Context, client *rpc.Client, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	var out hexutil.Bytes
+	if err := client.CallContext(ctx, &out, "eth_call", callArg(msg), blockArg(block)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// callArg encodes msg as the call object of eth_call and eth_estimateGas.
+func callArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
+
+// blockArg encodes a block number for JSON-RPC, latest if nil.
+func blockArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(number)
+}
+
+// parseABIErrors returns the custom errors declared in abiJSON keyed by
+// their 4 byte selector. The ABI parser in go-ethereum ignores error
+// entries so they are parsed here.
+func parseABIErrors(abiJSON string) (map[string]abiError, error) {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs []abi.ArgumentMarshaling
+	}
+	if err := json.Unmarshal([]byte(abiJSON), &fields); err != nil {
+		return nil, err
+	}
+
+	errs := make(map[string]abiError)
+	for _, f := range fields {
+		if f.Type != "error" {
+			continue
+		}
+
+		e := abiError{Name: f.Name}
+		for _, in := range f.Inputs {
+			t, err := abi.NewType(in.Type, in.InternalType, in.Components)
+			if err != nil {
+				return nil, err
+			}
+			e.Inputs = append(e.Inputs, abi.Argument{Name: in.Name, Type: t})
+		}
+
+		errs[string(crypto.Keccak256([]byte(e.sig()))[:4])] = e
+	}
+
+	return errs, nil
+}
+
+// sig returns the canonical signature of e, e.g. InsufficientBalance(uint256,uint256).
+func (e abiError) sig() string {
+	types := make([]string, len(e.Inputs))
+	for i, in := range e.Inputs {
+		types[i] = in.Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
+
+func formatErrorArgs(e abiError, values []interface{}) string {
+	args := make([]string, len(values))
+	for i, v := range values {
+		if name := e.Inputs[i].Name; name != "" {
+			args[i] = fmt.Sprintf("%v=%v", name, v)
+		} else {
+			args[i] = fmt.Sprint(v)
+		}
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(args, ", "))
+}
@@ -0,0 +1,245 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const revertTestABI = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[]}
+]`
+
+// revertData encodes a revert payload of the error sig with values.
+func revertData(t *testing.T, sig string, types []string, values ...interface{}) []byte {
+	var args abi.Arguments
+	for _, typ := range types {
+		at, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		args = append(args, abi.Argument{Type: at})
+	}
+	payload, err := args.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(sig))[:4], payload...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		wantKind    string
+		wantName    string
+		wantMessage string
+	}{
+		{
+			name:        "empty",
+			wantKind:    RevertUnknown,
+			wantMessage: "execution reverted without a reason",
+		},
+		{
+			name:        "error",
+			data:        revertData(t, "Error(string)", []string{"string"}, "Ownable: caller is not the owner"),
+			wantKind:    RevertError,
+			wantName:    "Error",
+			wantMessage: "Ownable: caller is not the owner",
+		},
+		{
+			name:        "panic",
+			data:        revertData(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x12)),
+			wantKind:    RevertPanic,
+			wantName:    "Panic",
+			wantMessage: "division or modulo by zero",
+		},
+		{
+			name:        "unknown panic",
+			data:        revertData(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99)),
+			wantKind:    RevertPanic,
+			wantName:    "Panic",
+			wantMessage: "unknown panic code 0x99",
+		},
+		{
+			name:        "custom",
+			data:        revertData(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(2)),
+			wantKind:    RevertCustom,
+			wantName:    "InsufficientBalance",
+			wantMessage: "InsufficientBalance(available=1, required=2)",
+		},
+		{
+			name:        "truncated error",
+			data:        revertData(t, "Error(string)", []string{"string"}, "nope")[:36],
+			wantKind:    RevertUnknown,
+			wantMessage: "unrecognised revert data",
+		},
+		{
+			name:        "unknown selector",
+			data:        common.FromHex("0xdeadbeef"),
+			wantKind:    RevertUnknown,
+			wantMessage: "unrecognised revert data",
+		},
+	}
+	for _, tt := range tests {
+		got := DecodeRevert(revertTestABI, tt.data)
+		if got.Kind != tt.wantKind || got.Name != tt.wantName || got.Message != tt.wantMessage {
+			t.Errorf("%v: DecodeRevert = %+v, want kind %v, name %v, message %q", tt.name, got, tt.wantKind, tt.wantName, tt.wantMessage)
+		}
+	}
+}
+
+func TestRevertFromError(t *testing.T) {
+	tests := []struct {
+		err         error
+		wantOK      bool
+		wantKind    string
+		wantMessage string
+	}{
+		{err: nil},
+		{err: errors.New("insufficient funds for gas * price + value")},
+		{err: errors.New("execution reverted"), wantOK: true, wantKind: RevertUnknown, wantMessage: "execution reverted without a reason"},
+		{err: errors.New("execution reverted: nope"), wantOK: true, wantKind: RevertError, wantMessage: "nope"},
+		{err: errors.New("VM Exception: execution reverted: a: b"), wantOK: true, wantKind: RevertError, wantMessage: "a: b"},
+	}
+	for _, tt := range tests {
+		got, ok := RevertFromError(tt.err, revertTestABI)
+		if ok != tt.wantOK {
+			t.Errorf("RevertFromError(%v) ok = %v, want %v", tt.err, ok, tt.wantOK)
+			continue
+		}
+		if ok && (got.Kind != tt.wantKind || got.Message != tt.wantMessage) {
+			t.Errorf("RevertFromError(%v) = %+v", tt.err, got)
+		}
+	}
+}
+
+// rpcErrorServer answers every JSON-RPC request with the error err, or
+// with result if err is nil.
+func rpcErrorServer(t *testing.T, result string, err string) *rpc.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err != "" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":%s}`, req.ID, err)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+	}))
+	t.Cleanup(server.Close)
+
+	client, dialErr := rpc.Dial(server.URL)
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestCall(t *testing.T) {
+	errorData := hexutil.Encode(revertData(t, "Error(string)", []string{"string"}, "Ownable: caller is not the owner"))
+	customData := hexutil.Encode(revertData(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(2)))
+
+	tests := []struct {
+		name        string
+		result      string
+		err         string
+		wantOut     string
+		wantKind    string
+		wantMessage string
+		wantErr     bool
+	}{
+		{
+			name:    "success",
+			result:  `"0x2a"`,
+			wantOut: "0x2a",
+		},
+		{
+			name:        "error data",
+			err:         `{"code":3,"message":"execution reverted: Ownable: caller is not the owner","data":"` + errorData + `"}`,
+			wantKind:    RevertError,
+			wantMessage: "Ownable: caller is not the owner",
+		},
+		{
+			name:        "custom error data",
+			err:         `{"code":3,"message":"execution reverted","data":"` + customData + `"}`,
+			wantKind:    RevertCustom,
+			wantMessage: "InsufficientBalance(available=1, required=2)",
+		},
+		{
+			name:        "prefixed data",
+			err:         `{"code":-32015,"message":"VM execution error.","data":"Reverted ` + customData + `"}`,
+			wantKind:    RevertCustom,
+			wantMessage: "InsufficientBalance(available=1, required=2)",
+		},
+		{
+			name:        "no data",
+			err:         `{"code":-32000,"message":"execution reverted"}`,
+			wantKind:    RevertUnknown,
+			wantMessage: "execution reverted without a reason",
+		},
+		{
+			name:    "insufficient funds",
+			err:     `{"code":-32000,"message":"insufficient funds for gas * price + value"}`,
+			wantErr: true,
+		},
+		{
+			name:    "out of gas",
+			err:     `{"code":-32000,"message":"out of gas"}`,
+			wantErr: true,
+		},
+	}
+	to := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	for _, tt := range tests {
+		client := rpcErrorServer(t, tt.result, tt.err)
+		out, reason, err := Call(context.Background(), client, ethereum.CallMsg{To: &to}, nil, revertTestABI)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%v: Call() error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantKind == "" {
+			if reason != nil {
+				t.Errorf("%v: Call() reverted with %+v", tt.name, reason)
+			}
+			if !tt.wantErr && hexutil.Encode(out) != tt.wantOut {
+				t.Errorf("%v: Call() = %x, want %v", tt.name, out, tt.wantOut)
+			}
+			continue
+		}
+		if reason == nil || reason.Kind != tt.wantKind || reason.Message != tt.wantMessage {
+			t.Errorf("%v: Call() reverted with %+v, want kind %v, message %q", tt.name, reason, tt.wantKind, tt.wantMessage)
+		}
+	}
+}
+
+func TestCallConnectionError(t *testing.T) {
+	client, err := rpc.Dial("http://127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	if _, reason, err := Call(context.Background(), client, ethereum.CallMsg{To: &to}, nil, ""); err == nil || reason != nil {
+		t.Errorf("Call() = %v, %v, want the connection error", reason, err)
+	}
+}
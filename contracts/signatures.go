@@ -0,0 +1,236 @@
+package contracts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Signature kinds
+const (
+	SignatureFunction = "function"
+	SignatureEvent    = "event"
+	SignatureError    = "error"
+)
+
+// Signature sources
+const (
+	SignatureSourceABI    = "abi"
+	SignatureSourceImport = "import"
+)
+
+// signatureBatchSize is the number of signatures inserted per statement.
+const signatureBatchSize = 500
+
+// maxInvalidSignatureLines bounds the invalid lines reported by an import.
+const maxInvalidSignatureLines = 100
+
+var signaturePattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*\([^\s]*\)$`)
+
+// Signature maps a function or error selector or an event topic to the
+// text signature it was hashed from. Selectors can collide, so there may
+// be several signatures for one selector.
+type Signature struct {
+	helpers.BaseModel
+	Kind     string `json:"kind" gorm:"unique_index:idx_signature"`
+	Selector string `json:"selector" gorm:"unique_index:idx_signature"`
+	Text     string `json:"signature" gorm:"unique_index:idx_signature"`
+	Source   string `json:"source"`
+}
+
+// NewSignature returns the signature of the given kind for text.
+func NewSignature(kind string, text string, source string) *Signature {
+	hash := crypto.Keccak256([]byte(text))
+	if kind != SignatureEvent {
+		hash = hash[:4]
+	}
+	return &Signature{Kind: kind, Selector: hexutil.Encode(hash), Text: text, Source: source}
+}
+
+// LookupSignatures returns the signatures of the given kinds for selector,
+// signatures taken from uploaded ABIs first.
+func LookupSignatures(selector string, kinds []string, db *gorm.DB) ([]*Signature, error) {
+	var sigs []*Signature
+	err := db.Where("selector = ? AND kind IN (?)", strings.ToLower(selector), kinds).
+		Order("source = 'abi' desc, text").Find(&sigs).Error
+	return sigs, err
+}
+
+// AfterCreate indexes the signatures of the ABI of a new contract.
+func (c *Contract) AfterCreate(scope *gorm.Scope) error {
+	return IndexSignatures(string(c.ABI.RawMessage), scope.NewDB())
+}
+
+// IndexSignatures stores the functions, events and errors of abiJSON.
+// Signatures which are already known are skipped.
+func IndexSignatures(abiJSON string, db *gorm.DB) error {
+	sigs, err := abiSignatures(abiJSON)
+	if err != nil {
+		return err
+	}
+	_, err = insertSignatures(sigs, db)
+	return err
+}
+
+// abiSignatures returns the signatures of abiJSON. Anonymous events have
+// no topic and are left out.
+func abiSignatures(abiJSON string) ([]*Signature, error) {
+	if abiJSON == "" {
+		return nil, nil
+	}
+	entries, err := abiEntries(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []*Signature
+	for typ, byType := range entries {
+		for sig, e := range byType {
+			if typ == SignatureEvent && e.anonymous {
+				continue
+			}
+			sigs = append(sigs, &Signature{Kind: typ, Selector: strings.ToLower(e.id), Text: sig, Source: SignatureSourceABI})
+		}
+	}
+	return sigs, nil
+}
+
+// BackfillSignatures indexes the ABIs of all contracts unless signatures
+// from ABIs were indexed before. It only has work to do once, for
+// contracts uploaded before signatures were indexed.
+func BackfillSignatures(db *gorm.DB) error {
+	var count int
+	if err := db.Model(&Signature{}).Where("source = ?", SignatureSourceABI).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var abis [][]byte
+	if err := db.Model(&Contract{}).Pluck("abi", &abis).Error; err != nil {
+		return err
+	}
+	for _, abiJSON := range abis {
+		// Invalid ABIs are refused on upload, older ones are skipped.
+		sigs, err := abiSignatures(string(abiJSON))
+		if err != nil {
+			continue
+		}
+		if _, err := insertSignatures(sigs, db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignatureImport is the result of importing a signature dump.
+type SignatureImport struct {
+	Kind     string   `json:"kind"`
+	Imported int64    `json:"imported"`
+	Known    int64    `json:"known"`
+	Invalid  int      `json:"invalid"`
+	Errors   []string `json:"errors"`
+}
+
+// ImportSignatures reads a 4byte style text dump of kind from r. Each line
+// holds a text signature, optionally preceded by its selector or topic as
+// 0x prefixed hex and a space, tab or comma. Selectors which do not match
+// the signature are reported as invalid. Empty lines and lines starting
+// with # are skipped. Signatures read before an error stay imported.
+func ImportSignatures(r io.Reader, kind string, db *gorm.DB) (*SignatureImport, error) {
+	res := &SignatureImport{Kind: kind, Errors: []string{}}
+	invalid := func(n int, format string, args ...interface{}) {
+		res.Invalid++
+		if len(res.Errors) < maxInvalidSignatureLines {
+			res.Errors = append(res.Errors, fmt.Sprintf("line %d: ", n)+fmt.Sprintf(format, args...))
+		}
+	}
+
+	var batch []*Signature
+	flush := func() error {
+		n, err := insertSignatures(batch, db)
+		res.Imported += n
+		res.Known += int64(len(batch)) - n
+		batch = batch[:0]
+		return err
+	}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var selector string
+		if strings.HasPrefix(line, "0x") {
+			end := strings.IndexAny(line, " \t,")
+			if end < 0 {
+				invalid(n, "missing signature")
+				continue
+			}
+			selector = strings.ToLower(line[:end])
+			line = strings.TrimSpace(line[end+1:])
+		}
+		if !signaturePattern.MatchString(line) {
+			invalid(n, "invalid signature %q", line)
+			continue
+		}
+
+		sig := NewSignature(kind, line, SignatureSourceImport)
+		if selector != "" && selector != sig.Selector {
+			invalid(n, "%v hashes to %v, not %v", line, sig.Selector, selector)
+			continue
+		}
+		batch = append(batch, sig)
+		if len(batch) == signatureBatchSize {
+			if err := flush(); err != nil {
+				return nil, helpers.ErrInternal(err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	if err := flush(); err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	return res, nil
+}
+
+// insertSignatures stores sigs in batches, skipping signatures which are
+// already known, and returns the number stored.
+func insertSignatures(sigs []*Signature, db *gorm.DB) (int64, error) {
+	var inserted int64
+	for start := 0; start < len(sigs); start += signatureBatchSize {
+		end := start + signatureBatchSize
+		if end > len(sigs) {
+			end = len(sigs)
+		}
+
+		now := time.Now()
+		values := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*7)
+		for _, s := range sigs[start:end] {
+			values = append(values, "(?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, uuid.NewV4(), now, now, s.Kind, s.Selector, s.Text, s.Source)
+		}
+		res := db.Exec("INSERT INTO signatures (id, created_at, updated_at, kind, selector, text, source) VALUES "+
+			strings.Join(values, ", ")+" ON CONFLICT DO NOTHING", args...)
+		if res.Error != nil {
+			return inserted, res.Error
+		}
+		inserted += res.RowsAffected
+	}
+	return inserted, nil
+}
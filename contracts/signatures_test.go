@@ -0,0 +1,209 @@
+package contracts
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/mislavio/contracter/testdb"
+)
+
+// expectSignatures expects the insert of sigs, of which inserted are new.
+func expectSignatures(mock sqlmock.Sqlmock, sigs []*Signature, inserted int64) {
+	values := map[int]interface{}{}
+	for i, s := range sigs {
+		values[i*7+4], values[i*7+5], values[i*7+6], values[i*7+7] = s.Kind, s.Selector, s.Text, s.Source
+	}
+	mock.ExpectExec(`INSERT INTO signatures \(id, created_at, updated_at, kind, selector, text, source\) VALUES .* ON CONFLICT DO NOTHING`).
+		WithArgs(testdb.Args(len(sigs)*7, values)...).
+		WillReturnResult(sqlmock.NewResult(0, inserted))
+}
+
+func TestImportSignatureDump(t *testing.T) {
+	transfer := NewSignature(SignatureFunction, "transfer(address,uint256)", SignatureSourceImport)
+	approve := NewSignature(SignatureFunction, "approve(address,uint256)", SignatureSourceImport)
+	babbage := NewSignature(SignatureFunction, "many_msg_babbage(bytes1)", SignatureSourceImport)
+	transferEvent := NewSignature(SignatureEvent, "Transfer(address,address,uint256)", SignatureSourceImport)
+
+	tests := []struct {
+		name     string
+		kind     string
+		dump     string
+		inserted []*Signature
+		new      int64
+		want     SignatureImport
+	}{
+		{
+			name:     "well-formed",
+			dump:     "# functions\n\ntransfer(address,uint256)\n0x095ea7b3,approve(address,uint256)\n  0xA9059CBB\tmany_msg_babbage(bytes1)  \n",
+			inserted: []*Signature{transfer, approve, babbage},
+			new:      3,
+			want:     SignatureImport{Kind: SignatureFunction, Imported: 3, Errors: []string{}},
+		},
+		{
+			name:     "events",
+			kind:     SignatureEvent,
+			dump:     transferEvent.Selector + " Transfer(address,address,uint256)",
+			inserted: []*Signature{transferEvent},
+			new:      1,
+			want:     SignatureImport{Kind: SignatureEvent, Imported: 1, Errors: []string{}},
+		},
+		{
+			name:     "malformed",
+			dump:     "0xa9059cbb\ntransfer(address, uint256)\n0x12345678 transfer(address,uint256)\nnot a signature\napprove(address,uint256)",
+			inserted: []*Signature{approve},
+			new:      1,
+			want: SignatureImport{Kind: SignatureFunction, Imported: 1, Invalid: 4, Errors: []string{
+				"line 1: missing signature",
+				`line 2: invalid signature "transfer(address, uint256)"`,
+				"line 3: transfer(address,uint256) hashes to 0xa9059cbb, not 0x12345678",
+				`line 4: invalid signature "not a signature"`,
+			}},
+		},
+		{
+			// The database skips signatures it knows, also those repeated
+			// in the dump.
+			name:     "duplicate",
+			dump:     "transfer(address,uint256)\napprove(address,uint256)\n0xa9059cbb transfer(address,uint256)",
+			inserted: []*Signature{transfer, approve, transfer},
+			new:      1,
+			want:     SignatureImport{Kind: SignatureFunction, Imported: 1, Known: 2, Errors: []string{}},
+		},
+		{
+			name: "comments only",
+			dump: "# nothing\n",
+			want: SignatureImport{Kind: SignatureFunction, Errors: []string{}},
+		},
+	}
+	for _, tt := range tests {
+		db, mock := testdb.New(t)
+		if tt.inserted != nil {
+			expectSignatures(mock, tt.inserted, tt.new)
+		}
+
+		target := "/signatures/import"
+		if tt.kind != "" {
+			target += "?kind=" + tt.kind
+		}
+		w := serve(ImportSignatureDump(db), http.MethodPost, target, tt.dump)
+		if w.Code != http.StatusCreated {
+			t.Errorf("%v: ImportSignatureDump() = %v %v, want 201", tt.name, w.Code, w.Body)
+			continue
+		}
+		var got SignatureImport
+		if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v: ImportSignatureDump() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestImportSignatureDumpKind(t *testing.T) {
+	db, _ := testdb.New(t)
+	w := serve(ImportSignatureDump(db), http.MethodPost, "/signatures/import?kind=constructor", "transfer(address,uint256)")
+	if got := errorDetail(w); w.Code != http.StatusBadRequest || got != "unknown signature kind constructor" {
+		t.Errorf("ImportSignatureDump(constructor) = %v %q, want 400 unknown signature kind constructor", w.Code, got)
+	}
+}
+
+func TestLookupSignatures(t *testing.T) {
+	topic := NewSignature(SignatureEvent, "Transfer(address,address,uint256)", "").Selector
+
+	tests := []struct {
+		target     string
+		selector   string
+		kinds      []driver.Value
+		found      [][2]string
+		wantStatus int
+		wantDetail string
+	}{
+		{
+			// Selectors collide, every candidate is returned in the order
+			// of the database.
+			target:   "/signatures/0xA9059CBB",
+			selector: "0xa9059cbb",
+			kinds:    []driver.Value{SignatureFunction, SignatureError},
+			found: [][2]string{
+				{"transfer(address,uint256)", SignatureSourceABI},
+				{"many_msg_babbage(bytes1)", SignatureSourceImport},
+				{"transfer(bytes4[9],bytes5[6],int48[11])", SignatureSourceImport},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			target:   "/topics/0x" + strings.ToUpper(topic[2:]),
+			selector: topic,
+			kinds:    []driver.Value{SignatureEvent},
+			found: [][2]string{
+				{"Transfer(address,address,uint256)", SignatureSourceABI},
+				{"Transfer(address,address,uint256,bytes)", SignatureSourceImport},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			target:     "/signatures/0x095ea7b3",
+			selector:   "0x095ea7b3",
+			kinds:      []driver.Value{SignatureFunction, SignatureError},
+			wantStatus: http.StatusNotFound,
+			wantDetail: "selector (0x095ea7b3) not found",
+		},
+		{
+			target:     "/signatures/0xa9059c",
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "selector must be 4 bytes of 0x prefixed hex",
+		},
+		{
+			target:     "/topics/0xa9059cbb",
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "topic must be 32 bytes of 0x prefixed hex",
+		},
+	}
+	for _, tt := range tests {
+		db, mock := testdb.New(t)
+		if tt.kinds != nil {
+			rows := sqlmock.NewRows([]string{"id", "kind", "selector", "text", "source"})
+			for _, f := range tt.found {
+				rows.AddRow(uuid.NewV4().String(), tt.kinds[0], tt.selector, f[0], f[1])
+			}
+			mock.ExpectQuery(`SELECT \* FROM "signatures" WHERE .*selector = \$1 AND kind IN \(.*\).* ORDER BY source = 'abi' desc, text`).
+				WithArgs(append([]driver.Value{tt.selector}, tt.kinds...)...).
+				WillReturnRows(rows)
+		}
+
+		router := chi.NewRouter()
+		router.Get("/signatures/{selector}", LookupSelector(db))
+		router.Get("/topics/{hash}", LookupTopic(db))
+		w := serve(router, http.MethodGet, tt.target, "")
+		if w.Code != tt.wantStatus {
+			t.Errorf("GET %v = %v %v, want %v", tt.target, w.Code, w.Body, tt.wantStatus)
+			continue
+		}
+		if tt.wantDetail != "" {
+			if got := errorDetail(w); got != tt.wantDetail {
+				t.Errorf("GET %v detail = %q, want %q", tt.target, got, tt.wantDetail)
+			}
+			continue
+		}
+
+		var res SignatureLookupResponse
+		if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		var got [][2]string
+		for _, s := range res.Signatures {
+			got = append(got, [2]string{s.Text, s.Source})
+		}
+		if res.Selector != tt.selector || !reflect.DeepEqual(got, tt.found) {
+			t.Errorf("GET %v = %v %v, want %v %v", tt.target, res.Selector, got, tt.selector, tt.found)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+//go:build ignore
+// +build ignore
+
+// Command generate compiles the Solidity sources in this directory with
+// solc and writes the artifacts of the named contracts to a Go file as
+// string constants which ParseArtifacts reads.
+//
+//	go run solidity/generate.go -o proxy_code.go name=source:Contract...
+//
+// A target name=file.json embeds a prebuilt artifact from the directory
+// instead, for contracts released with an older compiler which is not
+// rebuilt here.
+//
+// Sources under lib/openzeppelin-contracts/contracts and
+// lib/safe-contracts/contracts are compiled under their npm import paths,
+// @openzeppelin/contracts and @gnosis.pm/safe-contracts/contracts, so the
+// metadata matches a build against the published packages. solc is run
+// from $SOLC, or from the PATH, and must be compilerVersion.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compilerVersion is the solc release the artifacts are built with.
+const compilerVersion = "0.8.21+commit.d9974bed"
+
+// importPaths maps source directories to the import paths they are
+// compiled under.
+var importPaths = []struct{ dir, path string }{
+	{"lib/openzeppelin-contracts/contracts/", "@openzeppelin/contracts/"},
+	{"lib/safe-contracts/contracts/", "@gnosis.pm/safe-contracts/contracts/"},
+}
+
+type target struct {
+	name     string
+	source   string
+	contract string
+	prebuilt string
+}
+
+type source struct {
+	Content string `json:"content"`
+}
+
+type input struct {
+	Language string            `json:"language"`
+	Sources  map[string]source `json:"sources"`
+	Settings settings          `json:"settings"`
+}
+
+type settings struct {
+	Optimizer struct {
+		Enabled bool `json:"enabled"`
+		Runs    int  `json:"runs"`
+	} `json:"optimizer"`
+	EVMVersion      string                         `json:"evmVersion"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+type bytecode struct {
+	Object              string          `json:"object"`
+	LinkReferences      json.RawMessage `json:"linkReferences"`
+	ImmutableReferences json.RawMessage `json:"immutableReferences"`
+}
+
+type output struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		ABI           json.RawMessage `json:"abi"`
+		Metadata      string          `json:"metadata"`
+		StorageLayout json.RawMessage `json:"storageLayout"`
+		DevDoc        struct {
+			Details string `json:"details"`
+		} `json:"devdoc"`
+		EVM struct {
+			Bytecode         bytecode `json:"bytecode"`
+			DeployedBytecode bytecode `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+// artifact is the artifact format ParseArtifacts reads as a Hardhat
+// artifact, with the solc metadata, storage layout and compiler version.
+type artifact struct {
+	ContractName           string          `json:"contractName"`
+	SourceName             string          `json:"sourceName"`
+	ABI                    json.RawMessage `json:"abi"`
+	Bytecode               string          `json:"bytecode"`
+	DeployedBytecode       string          `json:"deployedBytecode"`
+	LinkReferences         json.RawMessage `json:"linkReferences"`
+	DeployedLinkReferences json.RawMessage `json:"deployedLinkReferences"`
+	ImmutableReferences    json.RawMessage `json:"immutableReferences"`
+	Compiler               struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+	Metadata      string          `json:"metadata"`
+	StorageLayout json.RawMessage `json:"storageLayout"`
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("solidity/generate: ")
+	out := flag.String("o", "", "output `file`")
+	dir := flag.String("dir", "solidity", "source `directory`")
+	flag.Parse()
+	if *out == "" || flag.NArg() == 0 {
+		log.Fatal("usage: generate -o file name=source:Contract...")
+	}
+
+	var targets []target
+	for _, arg := range flag.Args() {
+		i, j := strings.Index(arg, "="), strings.LastIndex(arg, ":")
+		if i >= 1 && strings.HasSuffix(arg, ".json") {
+			targets = append(targets, target{name: arg[:i], prebuilt: arg[i+1:]})
+			continue
+		}
+		if i < 1 || j < i {
+			log.Fatalf("invalid target %q, want name=source:Contract or name=file.json", arg)
+		}
+		targets = append(targets, target{name: arg[:i], source: arg[i+1 : j], contract: arg[j+1:]})
+	}
+
+	in, err := readSources(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, t := range targets {
+		if t.prebuilt != "" {
+			continue
+		}
+		if _, ok := in.Sources[t.source]; !ok {
+			log.Fatalf("%v: no such source", t.source)
+		}
+		if in.Settings.OutputSelection[t.source] == nil {
+			in.Settings.OutputSelection[t.source] = map[string][]string{}
+		}
+		in.Settings.OutputSelection[t.source][t.contract] = []string{
+			"abi",
+			"devdoc",
+			"metadata",
+			"storageLayout",
+			"evm.bytecode.object",
+			"evm.bytecode.linkReferences",
+			"evm.deployedBytecode.object",
+			"evm.deployedBytecode.linkReferences",
+			"evm.deployedBytecode.immutableReferences",
+		}
+	}
+
+	res, err := compile(in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	sources := map[string]bool{}
+	for _, t := range targets {
+		if t.prebuilt != "" {
+			sources[t.prebuilt] = true
+		} else {
+			sources[t.source] = true
+		}
+	}
+	fmt.Fprintf(&b, "// Code generated by solidity/generate.go from %v. DO NOT EDIT.\n\npackage contracts\n", strings.Join(sortedKeys(sources), ", "))
+
+	for _, t := range targets {
+		if t.prebuilt != "" {
+			a, data, err := readPrebuilt(filepath.Join(*dir, t.prebuilt))
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Fprintf(&b, "\n// %v is the prebuilt artifact of %v in %v,\n// compiled with solc %v.\n", t.name, a.ContractName, a.SourceName, a.Compiler.Version)
+			fmt.Fprintf(&b, "const %v = %v\n", t.name, quote(data))
+			continue
+		}
+
+		c, ok := res.Contracts[t.source][t.contract]
+		if !ok {
+			log.Fatalf("%v: no contract %v", t.source, t.contract)
+		}
+
+		var meta struct {
+			Compiler struct {
+				Version string `json:"version"`
+			} `json:"compiler"`
+		}
+		if err := json.Unmarshal([]byte(c.Metadata), &meta); err != nil {
+			log.Fatalf("%v: invalid metadata: %v", t.contract, err)
+		}
+		if meta.Compiler.Version != compilerVersion {
+			log.Fatalf("compiled with solc %v, want %v", meta.Compiler.Version, compilerVersion)
+		}
+
+		a := artifact{
+			ContractName:           t.contract,
+			SourceName:             t.source,
+			ABI:                    c.ABI,
+			Bytecode:               "0x" + c.EVM.Bytecode.Object,
+			DeployedBytecode:       "0x" + c.EVM.DeployedBytecode.Object,
+			LinkReferences:         c.EVM.Bytecode.LinkReferences,
+			DeployedLinkReferences: c.EVM.DeployedBytecode.LinkReferences,
+			ImmutableReferences:    c.EVM.DeployedBytecode.ImmutableReferences,
+			Metadata:               c.Metadata,
+			StorageLayout:          c.StorageLayout,
+		}
+		a.Compiler.Version = meta.Compiler.Version
+		data, err := json.Marshal(a)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Fprintf(&b, "\n// %v is the artifact of %v in %v.\n", t.name, t.contract, t.source)
+		if doc := firstSentence(c.DevDoc.Details); doc != "" {
+			b.WriteString("//\n")
+			for _, line := range wrap(doc, 72) {
+				fmt.Fprintf(&b, "// %v\n", line)
+			}
+		}
+		fmt.Fprintf(&b, "const %v = %v\n", t.name, quote(string(data)))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readSources returns the compiler input with every .sol file under dir.
+func readSources(dir string) (*input, error) {
+	in := &input{Language: "Solidity", Sources: map[string]source{}}
+	in.Settings.Optimizer.Enabled = true
+	in.Settings.Optimizer.Runs = 200
+	// go-ethereum's simulated backend runs Istanbul.
+	in.Settings.EVMVersion = "istanbul"
+	in.Settings.OutputSelection = map[string]map[string][]string{}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".sol" {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		in.Sources[importPath(filepath.ToSlash(rel))] = source{Content: string(content)}
+		return nil
+	})
+	return in, err
+}
+
+// readPrebuilt returns the artifact in the named file, compacted.
+func readPrebuilt(name string) (*artifact, string, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, "", err
+	}
+	a := &artifact{}
+	if err := json.Unmarshal(data, a); err != nil {
+		return nil, "", fmt.Errorf("%v: %v", name, err)
+	}
+	if a.ContractName == "" || len(a.ABI) == 0 || len(a.Bytecode) <= 2 || a.Compiler.Version == "" {
+		return nil, "", fmt.Errorf("%v: want contractName, abi, bytecode and compiler.version", name)
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, "", fmt.Errorf("%v: %v", name, err)
+	}
+	return a, buf.String(), nil
+}
+
+func importPath(rel string) string {
+	for _, p := range importPaths {
+		if strings.HasPrefix(rel, p.dir) {
+			return p.path + strings.TrimPrefix(rel, p.dir)
+		}
+	}
+	return rel
+}
+
+func compile(in *input) (*output, error) {
+	solc := os.Getenv("SOLC")
+	if solc == "" {
+		solc = "solc"
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(solc, "--standard-json")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", solc, err)
+	}
+
+	out := &output{}
+	if err := json.Unmarshal(stdout, out); err != nil {
+		return nil, fmt.Errorf("%v: %v", solc, err)
+	}
+	failed := false
+	for _, e := range out.Errors {
+		fmt.Fprint(os.Stderr, e.FormattedMessage)
+		failed = failed || e.Severity == "error"
+	}
+	if failed {
+		return nil, fmt.Errorf("compilation failed")
+	}
+	return out, nil
+}
+
+// quote returns s as a raw string literal unless it contains a backquote.
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// firstSentence returns the first sentence of NatSpec, which solc
+// returns with the line breaks removed.
+func firstSentence(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if i := strings.Index(s, ". "); i >= 0 {
+		s = s[:i+1]
+	}
+	return s
+}
+
+func wrap(s string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(s) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	return append(lines, line)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/extensions/ERC20Burnable.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+
+/**
+ * @dev ERC-20 token the owner can mint and holders can burn. The constructor
+ * mints `initialSupply` base units to `owner_` and hands the ownership to it.
+ */
+contract ContracterERC20 is ERC20, ERC20Burnable, Ownable {
+    uint8 private immutable _decimals;
+
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_,
+        uint256 initialSupply,
+        address owner_
+    ) ERC20(name_, symbol_) {
+        _decimals = decimals_;
+        transferOwnership(owner_);
+        if (initialSupply > 0) {
+            _mint(owner_, initialSupply);
+        }
+    }
+
+    function decimals() public view override returns (uint8) {
+        return _decimals;
+    }
+
+    function mint(address to, uint256 amount) public onlyOwner {
+        _mint(to, amount);
+    }
+}
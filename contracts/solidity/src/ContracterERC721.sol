@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/token/ERC721/ERC721.sol";
+import "@openzeppelin/contracts/token/ERC721/extensions/ERC721Burnable.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+
+/**
+ * @dev ERC-721 collection the owner can mint and holders can burn. Token URIs
+ * are `baseURI_` followed by the decimal token ID, or empty without it.
+ */
+contract ContracterERC721 is ERC721, ERC721Burnable, Ownable {
+    string private _baseTokenURI;
+
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        string memory baseURI_,
+        address owner_
+    ) ERC721(name_, symbol_) {
+        _baseTokenURI = baseURI_;
+        transferOwnership(owner_);
+    }
+
+    function mint(address to, uint256 tokenId) public onlyOwner {
+        _mint(to, tokenId);
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyOwner {
+        _safeMint(to, tokenId);
+    }
+
+    function _baseURI() internal view override returns (string memory) {
+        return _baseTokenURI;
+    }
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+import "@gnosis.pm/safe-contracts/contracts/proxies/GnosisSafeProxy.sol";
+
+/**
+ * @dev Safe proxy which runs the setup of its singleton, a Safe v1.3.0, on
+ * deployment. The Safe has no modules, fallback handler or guard, and the
+ * deployment reverts with the reason of a failing setup.
+ */
+contract ContracterSafe is GnosisSafeProxy {
+    constructor(address singleton_, address[] memory owners, uint256 threshold) GnosisSafeProxy(singleton_) {
+        require(singleton_.code.length > 0, "ContracterSafe: singleton has no code");
+        (bool success, bytes memory returndata) = singleton_.delegatecall(
+            abi.encodeWithSignature(
+                "setup(address[],uint256,address,bytes,address,address,uint256,address)",
+                owners,
+                threshold,
+                address(0),
+                new bytes(0),
+                address(0),
+                address(0),
+                uint256(0),
+                address(0)
+            )
+        );
+        if (!success) {
+            assembly {
+                revert(add(returndata, 32), mload(returndata))
+            }
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/proxy/utils/UUPSUpgradeable.sol";
+
+/**
+ * @dev Implementation deployed behind the proxies in the proxy tests. Anyone
+ * may upgrade a UUPS proxy pointing at it.
+ */
+contract Box is UUPSUpgradeable {
+    uint256 private _value;
+
+    function store(uint256 value) public {
+        _value = value;
+    }
+
+    function retrieve() public view returns (uint256) {
+        return _value;
+    }
+
+    function version() public pure virtual returns (uint256) {
+        return 1;
+    }
+
+    function fail() public pure {
+        revert("Box: failed");
+    }
+
+    function _authorizeUpgrade(address) internal override {}
+}
+
+/**
+ * @dev Second version of {Box}, telling the tests which implementation a
+ * proxy points at.
+ */
+contract BoxV2 is Box {
+    function version() public pure override returns (uint256) {
+        return 2;
+    }
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Accepts every ERC-721 and ERC-1155 hook by returning the selector it
+ * was called with, logging the calldata.
+ */
+contract EchoReceiver {
+    fallback() external {
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            log0(0, calldatasize())
+            mstore(0, shl(224, shr(224, calldataload(0))))
+            return(0, 32)
+        }
+    }
+}
+
+/**
+ * @dev Returns a value which is not the selector of any hook.
+ */
+contract GarbageReceiver {
+    fallback() external {
+        assembly {
+            mstore(0, shl(224, 0xdeadbeef))
+            return(0, 32)
+        }
+    }
+}
+
+/**
+ * @dev Reverts with a reason.
+ */
+contract ReverterReceiver {
+    fallback() external {
+        revert("receiver says no");
+    }
+}
+
+/**
+ * @dev Reverts without a reason.
+ */
+contract SilentReceiver {
+    fallback() external {
+        revert();
+    }
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Target of the batch tests, which reverts with a custom error.
+ */
+contract Thrower {
+    error InsufficientBalance(uint256 available, uint256 required);
+
+    function withdraw(uint256 amount) public pure returns (uint256) {
+        revert InsufficientBalance(1, amount);
+    }
+}
@@ -0,0 +1,218 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageValue is the value of a storage slot of a contract. Slot is as
+// requested and Keys are the mapping keys encoded for their KeyTypes,
+// StorageSlot is the slot read after applying them.
+type StorageValue struct {
+	ContractID  string   `json:"contractId"`
+	Network     string   `json:"network"`
+	Address     string   `json:"address"`
+	BlockNumber uint64   `json:"blockNumber"`
+	Slot        string   `json:"slot"`
+	Keys        []string `json:"keys,omitempty"`
+	KeyTypes    []string `json:"keyTypes,omitempty"`
+	StorageSlot string   `json:"storageSlot"`
+	Value       string   `json:"value"`
+}
+
+// AccountState is the state of an address at a block. CodeHash is the
+// hash of its code, the hash of empty code for accounts without code.
+type AccountState struct {
+	Network     string `json:"network"`
+	Address     string `json:"address"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Balance     string `json:"balance"`
+	Nonce       uint64 `json:"nonce"`
+	CodeHash    string `json:"codeHash"`
+	CodeSize    int    `json:"codeSize"`
+}
+
+// ParseSlot parses a storage slot given as decimal or 0x hex.
+func ParseSlot(s string) (common.Hash, error) {
+	n, ok := math.ParseBig256(s)
+	if !ok || n.Sign() < 0 {
+		return common.Hash{}, errors.New("invalid slot " + s)
+	}
+	return common.BigToHash(n), nil
+}
+
+// ParseStorageKey encodes a mapping key of the Solidity type keyType as
+// Solidity hashes it with the slot of the mapping. Value types are padded
+// to 32 bytes, bytesN on the right and integers, addresses and booleans on
+// the left. string and bytes keys are used unpadded, strings as their
+// text. Without a type, decimal keys are unsigned integers and 0x hex keys
+// shorter than 32 bytes, like addresses, are left padded.
+func ParseStorageKey(s string, keyType string) ([]byte, error) {
+	if keyType == "" {
+		return parseUntypedKey(s)
+	}
+	t, err := abi.NewType(keyType, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key type %v", keyType)
+	}
+	invalid := fmt.Errorf("invalid %v key %v", keyType, s)
+
+	switch t.T {
+	case abi.StringTy:
+		return []byte(s), nil
+	case abi.BytesTy:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return nil, invalid
+		}
+		return b, nil
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(s)
+		if err != nil || len(b) > t.Size {
+			return nil, invalid
+		}
+		return common.RightPadBytes(b, common.HashLength), nil
+	case abi.AddressTy:
+		if !common.IsHexAddress(s) {
+			return nil, invalid
+		}
+		return common.HexToAddress(s).Hash().Bytes(), nil
+	case abi.BoolTy:
+		switch s {
+		case "true", "1":
+			return common.BigToHash(common.Big1).Bytes(), nil
+		case "false", "0":
+			return common.Hash{}.Bytes(), nil
+		}
+		return nil, invalid
+	case abi.IntTy, abi.UintTy:
+		n, err := parseInteger(json.RawMessage(s))
+		if err != nil || !fitsInteger(t, n) {
+			return nil, invalid
+		}
+		// Negative keys are sign extended to 32 bytes.
+		return common.BigToHash(math.U256(n)).Bytes(), nil
+	}
+	return nil, fmt.Errorf("%v is not a mapping key type", keyType)
+}
+
+func parseUntypedKey(s string) ([]byte, error) {
+	if strings.HasPrefix(s, "0x") {
+		if len(s)%2 == 1 {
+			s = "0x0" + s[2:]
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil || len(b) > common.HashLength {
+			return nil, errors.New("invalid key " + s)
+		}
+		return common.LeftPadBytes(b, common.HashLength), nil
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+		return nil, errors.New("invalid key " + s)
+	}
+	return common.BigToHash(n).Bytes(), nil
+}
+
+// MappingSlot returns the slot of the value of a mapping at slot, nested
+// mappings taking one key per level. Solidity stores mapping[key] at
+// keccak256(key . slot) with key encoded by ParseStorageKey.
+func MappingSlot(slot common.Hash, keys ...[]byte) common.Hash {
+	for _, key := range keys {
+		slot = crypto.Keccak256Hash(key, slot.Bytes())
+	}
+	return slot
+}
+
+// MappingKeyTypes returns the key types of n nested mappings of the
+// variable at slot, or nil if the layout has no such mapping there.
+func (l *StorageLayout) MappingKeyTypes(slot common.Hash, n int) []string {
+	for _, v := range l.Storage {
+		at, ok := new(big.Int).SetString(v.Slot, 10)
+		if !ok || v.Offset != 0 || common.BigToHash(at) != slot {
+			continue
+		}
+		types := make([]string, 0, n)
+		id := v.Type
+		for len(types) < n {
+			t := l.Types[id]
+			if t.Encoding != "mapping" {
+				return nil
+			}
+			types = append(types, keyTypeName(l.Types[t.Key].Label))
+			id = t.Value
+		}
+		return types
+	}
+	return nil
+}
+
+// keyTypeName returns the ABI type of a key type label of a storage
+// layout. Contracts are keyed by address and enums by uint8.
+func keyTypeName(label string) string {
+	switch {
+	case strings.HasPrefix(label, "contract "), strings.HasPrefix(label, "address "):
+		return "address"
+	case strings.HasPrefix(label, "enum "):
+		return "uint8"
+	}
+	return label
+}
+
+// ReadStorage returns the value of slot of the contract at address at
+// block.
+func ReadStorage(ctx context.Context, client *Client, address common.Address, slot common.Hash, block *big.Int) (common.Hash, error) {
+	value, err := client.StorageAt(ctx, address, slot, block)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// ReadAccountState returns the balance, nonce and code of address at
+// block.
+func ReadAccountState(ctx context.Context, client *Client, address common.Address, block *big.Int) (*AccountState, error) {
+	balance, err := client.BalanceAt(ctx, address, block)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := client.NonceAt(ctx, address, block)
+	if err != nil {
+		return nil, err
+	}
+	code, err := client.CodeAt(ctx, address, block)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountState{
+		Address:     address.Hex(),
+		BlockNumber: block.Uint64(),
+		Balance:     balance.String(),
+		Nonce:       nonce,
+		CodeHash:    crypto.Keccak256Hash(code).Hex(),
+		CodeSize:    len(code),
+	}, nil
+}
+
+// PinBlock returns block, or the number of the head if it is nil, so that
+// several reads see the same state.
+func PinBlock(ctx context.Context, client *Client, block *big.Int) (*big.Int, error) {
+	if block != nil {
+		return block, nil
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return header.Number, nil
+}
@@ -0,0 +1,225 @@
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mislavio/contracter/testchain"
+)
+
+// The sample contract of the README: a string version at slot 0 and a
+// mapping(bytes32 => bytes32) items at slot 1.
+const (
+	sampleABI      = `[{"inputs":[{"internalType":"string","name":"_version","type":"string"}],"stateMutability":"nonpayable","type":"constructor"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes32","name":"key","type":"bytes32"},{"indexed":false,"internalType":"bytes32","name":"value","type":"bytes32"}],"name":"ItemSet","type":"event"},{"inputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"name":"items","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"bytes32","name":"key","type":"bytes32"},{"internalType":"bytes32","name":"value","type":"bytes32"}],"name":"setItem","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"version","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}]`
+	sampleBytecode = "608060405234801561001057600080fd5b506040516104493803806104498339818101604052602081101561003357600080fd5b810190808051604051939291908464010000000082111561005357600080fd5b8382019150602082018581111561006957600080fd5b825186600182028301116401000000008211171561008657600080fd5b8083526020830192505050908051906020019080838360005b838110156100ba57808201518184015260208101905061009f565b50505050905090810190601f1680156100e75780820380516001836020036101000a031916815260200191505b50604052505050806000908051906020019061010492919061010b565b50506101b0565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061014c57805160ff191683800117855561017a565b8280016001018555821561017a579182015b8281111561017957825182559160200191906001019061015e565b5b509050610187919061018b565b5090565b6101ad91905b808211156101a9576000816000905550600101610191565b5090565b90565b61028a806101bf6000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c806348f343f31461004657806354fd4d5014610088578063f56256c71461010b575b600080fd5b6100726004803603602081101561005c57600080fd5b8101908080359060200190929190505050610143565b6040518082815260200191505060405180910390f35b61009061015b565b6040518080602001828103825283818151815260200191508051906020019080838360005b838110156100d05780820151818401526020810190506100b5565b50505050905090810190601f1680156100fd5780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6101416004803603604081101561012157600080fd5b8101908080359060200190929190803590602001909291905050506101f9565b005b60016020528060005260406000206000915090505481565b60008054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156101f15780601f106101c6576101008083540402835291602001916101f1565b820191906000526020600020905b8154815290600101906020018083116101d457829003601f168201915b505050505081565b8060016000848152602001908152602001600020819055507fe79e73da417710ae99aa2088575580a60415d359acfad9cdd3382d59c80281d48282604051808381526020018281526020019250505060405180910390a1505056fea26469706673582212209c226abe2705af69cabaab8ddf898fbf689e140aeb56f84de7fdb495441e23e764736f6c63430006060033"
+)
+
+func TestParseSlot(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    common.Hash
+		wantErr bool
+	}{
+		{s: "0", want: common.Hash{}},
+		{s: "1", want: common.BigToHash(big.NewInt(1))},
+		{s: "0x10", want: common.BigToHash(big.NewInt(16))},
+		{s: "0x" + strings.Repeat("ff", 32), want: common.HexToHash("0x" + strings.Repeat("ff", 32))},
+		{s: "0x1" + strings.Repeat("00", 32), wantErr: true},
+		{s: "-1", wantErr: true},
+		{s: "slot", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSlot(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSlot(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSlot(%q) = %v, want %v", tt.s, got.Hex(), tt.want.Hex())
+		}
+	}
+}
+
+func TestParseStorageKey(t *testing.T) {
+	tests := []struct {
+		s       string
+		keyType string
+		want    string
+		wantErr bool
+	}{
+		{s: "0x00000000000000000000000000000000000000aa", want: "0x00000000000000000000000000000000000000000000000000000000000000aa"},
+		{s: "0xabc", want: "0x0000000000000000000000000000000000000000000000000000000000000abc"},
+		{s: "0x" + strings.Repeat("ab", 32), want: "0x" + strings.Repeat("ab", 32)},
+		{s: "255", want: "0x00000000000000000000000000000000000000000000000000000000000000ff"},
+		{s: "0", want: "0x0000000000000000000000000000000000000000000000000000000000000000"},
+		{s: "0x" + strings.Repeat("ab", 33), wantErr: true},
+		{s: "0xzz", wantErr: true},
+		{s: "-1", wantErr: true},
+		{s: "1" + strings.Repeat("0", 78), wantErr: true},
+		{s: "key", wantErr: true},
+
+		{s: "0xabcd", keyType: "bytes4", want: "0xabcd000000000000000000000000000000000000000000000000000000000000"},
+		{s: "0x12345678", keyType: "bytes4", want: "0x1234567800000000000000000000000000000000000000000000000000000000"},
+		{s: "0x" + strings.Repeat("ab", 32), keyType: "bytes32", want: "0x" + strings.Repeat("ab", 32)},
+		{s: "0x1234567890", keyType: "bytes4", wantErr: true},
+		{s: "12", keyType: "bytes4", wantErr: true},
+		{s: "0xab", keyType: "bytes", want: "0xab"},
+		{s: "0x", keyType: "bytes", want: "0x"},
+		{s: "ab", keyType: "bytes", wantErr: true},
+		{s: "key", keyType: "string", want: "0x6b6579"},
+		{s: "0xab", keyType: "string", want: "0x30786162"},
+		{s: "0x00000000000000000000000000000000000000aa", keyType: "address", want: "0x00000000000000000000000000000000000000000000000000000000000000aa"},
+		{s: "0xaa", keyType: "address", wantErr: true},
+		{s: "0x10", keyType: "uint256", want: "0x0000000000000000000000000000000000000000000000000000000000000010"},
+		{s: "10", keyType: "uint8", want: "0x000000000000000000000000000000000000000000000000000000000000000a"},
+		{s: "256", keyType: "uint8", wantErr: true},
+		{s: "-1", keyType: "uint256", wantErr: true},
+		{s: "-1", keyType: "int8", want: "0x" + strings.Repeat("ff", 32)},
+		{s: "-129", keyType: "int8", wantErr: true},
+		{s: "true", keyType: "bool", want: "0x0000000000000000000000000000000000000000000000000000000000000001"},
+		{s: "0", keyType: "bool", want: "0x0000000000000000000000000000000000000000000000000000000000000000"},
+		{s: "yes", keyType: "bool", wantErr: true},
+		{s: "1", keyType: "uint256[]", wantErr: true},
+		{s: "1", keyType: "word", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStorageKey(tt.s, tt.keyType)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStorageKey(%q, %q) error = %v, wantErr %v", tt.s, tt.keyType, err, tt.wantErr)
+			continue
+		}
+		if err == nil && hexutil.Encode(got) != tt.want {
+			t.Errorf("ParseStorageKey(%q, %q) = %v, want %v", tt.s, tt.keyType, hexutil.Encode(got), tt.want)
+		}
+	}
+}
+
+func TestMappingKeyTypes(t *testing.T) {
+	layout := &StorageLayout{
+		Storage: []StorageVariable{
+			{Label: "version", Slot: "0", Type: "t_string_storage"},
+			{Label: "items", Slot: "1", Type: "t_mapping(t_bytes32,t_bytes32)"},
+			{Label: "allowances", Slot: "2", Type: "t_mapping(t_address,t_mapping(t_contract(IERC20)12,t_uint256))"},
+			{Label: "names", Slot: "3", Type: "t_mapping(t_string_memory_ptr,t_uint256)"},
+		},
+		Types: map[string]StorageType{
+			"t_string_storage":               {Encoding: "bytes", Label: "string"},
+			"t_bytes32":                      {Encoding: "inplace", Label: "bytes32"},
+			"t_address":                      {Encoding: "inplace", Label: "address"},
+			"t_contract(IERC20)12":           {Encoding: "inplace", Label: "contract IERC20"},
+			"t_uint256":                      {Encoding: "inplace", Label: "uint256"},
+			"t_string_memory_ptr":            {Encoding: "bytes", Label: "string"},
+			"t_mapping(t_bytes32,t_bytes32)": {Encoding: "mapping", Label: "mapping(bytes32 => bytes32)", Key: "t_bytes32", Value: "t_bytes32"},
+			"t_mapping(t_string_memory_ptr,t_uint256)":                       {Encoding: "mapping", Label: "mapping(string => uint256)", Key: "t_string_memory_ptr", Value: "t_uint256"},
+			"t_mapping(t_address,t_mapping(t_contract(IERC20)12,t_uint256))": {Encoding: "mapping", Key: "t_address", Value: "t_mapping(t_contract(IERC20)12,t_uint256)"},
+			"t_mapping(t_contract(IERC20)12,t_uint256)":                      {Encoding: "mapping", Key: "t_contract(IERC20)12", Value: "t_uint256"},
+		},
+	}
+
+	tests := []struct {
+		slot int64
+		n    int
+		want []string
+	}{
+		{slot: 1, n: 1, want: []string{"bytes32"}},
+		{slot: 2, n: 2, want: []string{"address", "address"}},
+		{slot: 2, n: 1, want: []string{"address"}},
+		{slot: 3, n: 1, want: []string{"string"}},
+		{slot: 1, n: 2},
+		{slot: 0, n: 1},
+		{slot: 4, n: 1},
+	}
+	for _, tt := range tests {
+		got := layout.MappingKeyTypes(common.BigToHash(big.NewInt(tt.slot)), tt.n)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MappingKeyTypes(%d, %d) = %v, want %v", tt.slot, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMappingSlot(t *testing.T) {
+	// Slots as computed by solc, keccak256(abi.encode(key, slot)).
+	tests := []struct {
+		slot common.Hash
+		keys [][]byte
+		want common.Hash
+	}{
+		{slot: common.HexToHash("0x1"), want: common.HexToHash("0x1")},
+		{
+			slot: common.Hash{},
+			keys: [][]byte{make([]byte, 32)},
+			want: common.HexToHash("0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5"),
+		},
+		{
+			slot: common.HexToHash("0x1"),
+			keys: [][]byte{make([]byte, 32)},
+			want: common.HexToHash("0xa6eef7e35abe7026729641147f7915573c7e97b47efa546f5f6e3230263bcb49"),
+		},
+		{
+			slot: common.Hash{},
+			keys: [][]byte{make([]byte, 32), make([]byte, 32)},
+			want: crypto.Keccak256Hash(make([]byte, 32), common.HexToHash("0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5").Bytes()),
+		},
+	}
+	for _, tt := range tests {
+		if got := MappingSlot(tt.slot, tt.keys...); got != tt.want {
+			t.Errorf("MappingSlot(%v, %v) = %v, want %v", tt.slot.Hex(), tt.keys, got.Hex(), tt.want.Hex())
+		}
+	}
+}
+
+// TestMappingSlotSample reads items of the sample contract from its
+// storage.
+func TestMappingSlotSample(t *testing.T) {
+	sim := testchain.New(t, 8000000, 1, nil)
+	auth := sim.Accounts[0]
+
+	parsed, err := abi.JSON(strings.NewReader(sampleABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, _, sample, err := bind.DeployContract(auth, parsed, common.FromHex(sampleBytecode), sim, "1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	// bytes32 keys shorter than 32 bytes are right padded, the key is
+	// bytes32("\xab\xcd") in Solidity.
+	itemKey, err := ParseStorageKey("0xabcd", "bytes32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var item [32]byte
+	copy(item[:], []byte{0xab, 0xcd})
+	value := common.HexToHash("0x1234")
+	if _, err := sample.Transact(auth, "setItem", item, value); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	got, err := sim.StorageAt(context.Background(), address, MappingSlot(common.BigToHash(big.NewInt(1)), itemKey), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.BytesToHash(got) != value {
+		t.Errorf("items[%x] = %x, want %v", itemKey, got, value.Hex())
+	}
+
+	// Short strings are stored inline with twice their length last.
+	version, err := sim.StorageAt(context.Background(), address, common.Hash{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := append([]byte("1.0.0"), make([]byte, 26)...); string(version[:31]) != string(want) || version[31] != 10 {
+		t.Errorf("version slot = %x", version)
+	}
+}
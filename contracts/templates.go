@@ -0,0 +1,423 @@
+package contracts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+)
+
+//go:generate go run solidity/generate.go -o template_code.go erc20Template=src/ContracterERC20.sol:ContracterERC20 erc721Template=src/ContracterERC721.sol:ContracterERC721 erc1155Template=src/ContracterERC1155.sol:ContracterERC1155 multisigTemplate=src/ContracterSafe.sol:ContracterSafe timelockTemplate=@openzeppelin/contracts/governance/TimelockController.sol:TimelockController safeArtifact=lib/safe-contracts/build/GnosisSafe.json
+//go:generate go run solidity/generate.go -o template_code_test.go echoReceiverArtifact=test/Receivers.sol:EchoReceiver garbageReceiverArtifact=test/Receivers.sol:GarbageReceiver reverterReceiverArtifact=test/Receivers.sol:ReverterReceiver silentReceiverArtifact=test/Receivers.sol:SilentReceiver
+
+// Template parameter types and formats, as in JSON Schema.
+const (
+	ParamString  = "string"
+	ParamInteger = "integer"
+	ParamArray   = "array"
+
+	FormatAddress = "address"
+	FormatDecimal = "decimal"
+)
+
+// TemplateParameter is a constructor parameter of a template described as
+// a JSON Schema property.
+type TemplateParameter struct {
+	Name        string             `json:"-"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Default     interface{}        `json:"default,omitempty"`
+	MinLength   int                `json:"minLength,omitempty"`
+	MaxLength   int                `json:"maxLength,omitempty"`
+	Minimum     *int64             `json:"minimum,omitempty"`
+	Maximum     *int64             `json:"maximum,omitempty"`
+	Items       *TemplateParameter `json:"items,omitempty"`
+	MinItems    int                `json:"minItems,omitempty"`
+	MaxItems    int                `json:"maxItems,omitempty"`
+	UniqueItems bool               `json:"uniqueItems,omitempty"`
+	Required    bool               `json:"-"`
+	// WalletDefault defaults an address to the wallet deploying the template.
+	WalletDefault bool `json:"-"`
+}
+
+// Template is a precompiled contract shipped with Contracter which is
+// deployed from parameters instead of uploaded bytecode.
+type Template struct {
+	Name        string
+	Description string
+	// Standard is the token standard the template implements, if any.
+	Standard   string
+	Parameters []*TemplateParameter
+
+	// artifact is the solc artifact of the template contract.
+	artifact string
+	// implementation is the artifact of the contract a proxy template
+	// delegates to, whose functions and events are added to its ABI.
+	implementation string
+	// args returns the constructor arguments for validated parameters.
+	args func(p map[string]interface{}) ([]interface{}, error)
+}
+
+// safeSingleton is the address of the Safe v1.3.0 singleton, deployed at
+// the same address on every network with the Safe singleton factory.
+var safeSingleton = common.HexToAddress("0xd9Db270c1B5E3Bd161E8c8503c55cEABeE709552")
+
+func int64p(v int64) *int64 {
+	return &v
+}
+
+func ownerParameter(role string) *TemplateParameter {
+	return &TemplateParameter{
+		Name:          "owner",
+		Type:          ParamString,
+		Format:        FormatAddress,
+		Description:   role + " Defaults to the deploying wallet.",
+		WalletDefault: true,
+	}
+}
+
+var templates = []*Template{
+	{
+		Name:        "erc20",
+		Description: "ERC-20 token the owner can mint and holders can burn.",
+		Standard:    StandardERC20,
+		Parameters: []*TemplateParameter{
+			{Name: "name", Type: ParamString, Description: "Token name.", Required: true, MinLength: 1, MaxLength: 64},
+			{Name: "symbol", Type: ParamString, Description: "Token symbol.", Required: true, MinLength: 1, MaxLength: 16},
+			{Name: "decimals", Type: ParamInteger, Description: "Decimals of token amounts.", Default: 18, Minimum: int64p(0), Maximum: int64p(36)},
+			{Name: "initialSupply", Type: ParamString, Format: FormatDecimal, Description: "Tokens minted to the owner, in whole tokens such as 1000.5.", Default: "0"},
+			ownerParameter("Account which may mint and receives the initial supply."),
+		},
+		artifact: erc20Template,
+		args: func(p map[string]interface{}) ([]interface{}, error) {
+			decimals := uint8(p["decimals"].(*big.Int).Uint64())
+			supply, err := ParseUnits(p["initialSupply"].(string), decimals)
+			if err != nil {
+				return nil, fmt.Errorf("initialSupply: %v", err)
+			}
+			return []interface{}{p["name"], p["symbol"], decimals, supply, p["owner"]}, nil
+		},
+	},
+	{
+		Name:        "erc721",
+		Description: "ERC-721 collection with metadata the owner can mint and holders can burn.",
+		Standard:    StandardERC721,
+		Parameters: []*TemplateParameter{
+			{Name: "name", Type: ParamString, Description: "Collection name.", Required: true, MinLength: 1, MaxLength: 64},
+			{Name: "symbol", Type: ParamString, Description: "Collection symbol.", Required: true, MinLength: 1, MaxLength: 16},
+			{Name: "baseURI", Type: ParamString, Description: "Prefix of token URIs, followed by the decimal token ID. Token URIs are empty without it.", Default: ""},
+			ownerParameter("Account which may mint."),
+		},
+		artifact: erc721Template,
+		args: func(p map[string]interface{}) ([]interface{}, error) {
+			return []interface{}{p["name"], p["symbol"], p["baseURI"], p["owner"]}, nil
+		},
+	},
+	{
+		Name:        "erc1155",
+		Description: "ERC-1155 multi token the owner can mint and holders can burn.",
+		Standard:    StandardERC1155,
+		Parameters: []*TemplateParameter{
+			{Name: "uri", Type: ParamString, Description: "Metadata URI of all tokens, clients replace {id} with the hex token ID.", Required: true, MinLength: 1},
+			ownerParameter("Account which may mint."),
+		},
+		artifact: erc1155Template,
+		args: func(p map[string]interface{}) ([]interface{}, error) {
+			return []interface{}{p["uri"], p["owner"]}, nil
+		},
+	},
+	{
+		Name:        "multisig",
+		Description: "Safe v1.3.0 wallet executing transactions once a threshold of its owners signed them.",
+		Parameters: []*TemplateParameter{
+			{
+				Name:        "owners",
+				Type:        ParamArray,
+				Description: "Owners which confirm and execute transactions.",
+				Required:    true,
+				Items:       &TemplateParameter{Type: ParamString, Format: FormatAddress},
+				MinItems:    1,
+				MaxItems:    255,
+				UniqueItems: true,
+			},
+			{Name: "threshold", Type: ParamInteger, Description: "Signatures needed to execute a transaction, at most the number of owners.", Required: true, Minimum: int64p(1), Maximum: int64p(255)},
+			{Name: "singleton", Type: ParamString, Format: FormatAddress, Description: "Safe v1.3.0 singleton the wallet delegates to. Defaults to the canonical deployment.", Default: safeSingleton.Hex()},
+		},
+		artifact:       multisigTemplate,
+		implementation: safeArtifact,
+		args: func(p map[string]interface{}) ([]interface{}, error) {
+			owners, threshold := p["owners"].([]common.Address), p["threshold"].(*big.Int)
+			for _, o := range owners {
+				if o == (common.Address{}) {
+					return nil, errors.New("owners: zero address")
+				}
+			}
+			if threshold.Cmp(big.NewInt(int64(len(owners)))) > 0 {
+				return nil, fmt.Errorf("threshold: %v exceeds the %d owners", threshold, len(owners))
+			}
+			return []interface{}{p["singleton"], owners, threshold}, nil
+		},
+	},
+	{
+		Name:        "timelock",
+		Description: "Executes calls scheduled by its owner once a minimum delay passed.",
+		Parameters: []*TemplateParameter{
+			{Name: "minDelay", Type: ParamInteger, Description: "Minimum delay of operations in seconds.", Required: true, Minimum: int64p(0)},
+			ownerParameter("Account which schedules and cancels operations."),
+		},
+		artifact: timelockTemplate,
+		// The owner proposes and cancels, anyone executes and the timelock
+		// administers itself.
+		args: func(p map[string]interface{}) ([]interface{}, error) {
+			owner := p["owner"].(common.Address)
+			if owner == (common.Address{}) {
+				return nil, errors.New("owner: zero address")
+			}
+			return []interface{}{p["minDelay"], []common.Address{owner}, []common.Address{{}}, common.Address{}}, nil
+		},
+	},
+}
+
+// Templates returns the template catalog ordered by name.
+func Templates() []*Template {
+	list := append([]*Template{}, templates...)
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// FindTemplate returns the template with the given name.
+func FindTemplate(name string) (*Template, bool) {
+	for _, t := range templates {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// ABI returns the ABI of the template contract.
+func (t *Template) ABI() json.RawMessage {
+	return t.Artifact().ABI
+}
+
+// Schema returns the parameters of t as a JSON Schema object.
+func (t *Template) Schema() map[string]interface{} {
+	properties := map[string]*TemplateParameter{}
+	required := []string{}
+	for _, p := range t.Parameters {
+		properties[p.Name] = p
+		if p.Required {
+			required = append(required, p.Name)
+		}
+	}
+	return map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// Artifact returns the compiled template, registered like an uploaded
+// artifact.
+func (t *Template) Artifact() *Artifact {
+	a := mustParseArtifact(t.artifact)
+	if t.implementation != "" {
+		a.ABI = mergeABI(a.ABI, mustParseArtifact(t.implementation).ABI)
+	}
+	return a
+}
+
+// mergeABI returns proxy with the entries of implementation other than
+// its constructor, fallback and receive function appended.
+func mergeABI(proxy, implementation json.RawMessage) json.RawMessage {
+	var entries, impl []map[string]interface{}
+	if err := json.Unmarshal(proxy, &entries); err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(implementation, &impl); err != nil {
+		panic(err)
+	}
+	for _, e := range impl {
+		switch e["type"] {
+		case "constructor", "fallback", "receive":
+			continue
+		}
+		entries = append(entries, e)
+	}
+	merged, err := json.Marshal(entries)
+	if err != nil {
+		panic(err)
+	}
+	return merged
+}
+
+// ConstructorArgs validates params against the parameters of t and returns
+// the JSON encoded constructor arguments. Missing parameters take their
+// default, wallet is only called for those defaulting to the wallet and
+// its errors are returned as they are.
+func (t *Template) ConstructorArgs(params map[string]json.RawMessage, wallet func() (common.Address, error)) ([]json.RawMessage, error) {
+	known := map[string]bool{}
+	values := map[string]interface{}{}
+	for _, p := range t.Parameters {
+		known[p.Name] = true
+
+		raw, ok := params[p.Name]
+		if !ok || string(raw) == "null" {
+			switch {
+			case p.Required:
+				return nil, helpers.ErrBadRequest(fmt.Errorf("missing parameter %v", p.Name))
+			case p.WalletDefault:
+				w, err := wallet()
+				if err != nil {
+					return nil, err
+				}
+				values[p.Name] = w
+				continue
+			}
+			raw, _ = json.Marshal(p.Default)
+		}
+
+		v, err := p.parse(raw)
+		if err != nil {
+			return nil, helpers.ErrBadRequest(fmt.Errorf("%v: %v", p.Name, err))
+		}
+		values[p.Name] = v
+	}
+
+	var unknown []string
+	for name := range params {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, helpers.ErrBadRequest(fmt.Errorf("unknown parameters %v", strings.Join(unknown, ", ")))
+	}
+
+	args, err := t.args(values)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	out := make([]json.RawMessage, len(args))
+	for i, a := range args {
+		if out[i], err = json.Marshal(a); err != nil {
+			return nil, helpers.ErrInternal(err)
+		}
+	}
+	return out, nil
+}
+
+// parse validates raw against p. Strings are returned as string or, for
+// addresses, common.Address, and integers as *big.Int. Arrays are arrays of
+// addresses in the catalog and returned as []common.Address.
+func (p *TemplateParameter) parse(raw json.RawMessage) (interface{}, error) {
+	switch p.Type {
+	case ParamString:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, errors.New("expected a string")
+		}
+		if len(s) < p.MinLength {
+			return nil, fmt.Errorf("shorter than %d characters", p.MinLength)
+		}
+		if p.MaxLength > 0 && len(s) > p.MaxLength {
+			return nil, fmt.Errorf("longer than %d characters", p.MaxLength)
+		}
+		switch p.Format {
+		case FormatAddress:
+			if !common.IsHexAddress(s) {
+				return nil, fmt.Errorf("invalid address %q", s)
+			}
+			return common.HexToAddress(s), nil
+		case FormatDecimal:
+			if !amountPattern.MatchString(s) {
+				return nil, fmt.Errorf("invalid amount %q, expected a decimal number such as 1.5", s)
+			}
+		}
+		return s, nil
+
+	case ParamInteger:
+		n, err := parseInteger(raw)
+		if err != nil {
+			return nil, err
+		}
+		if p.Minimum != nil && n.Cmp(big.NewInt(*p.Minimum)) < 0 {
+			return nil, fmt.Errorf("less than %d", *p.Minimum)
+		}
+		if p.Maximum != nil && n.Cmp(big.NewInt(*p.Maximum)) > 0 {
+			return nil, fmt.Errorf("greater than %d", *p.Maximum)
+		}
+		if n.BitLen() > 256 {
+			return nil, errors.New("does not fit into uint256")
+		}
+		return n, nil
+
+	case ParamArray:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, errors.New("expected an array")
+		}
+		if len(items) < p.MinItems {
+			return nil, fmt.Errorf("fewer than %d items", p.MinItems)
+		}
+		if p.MaxItems > 0 && len(items) > p.MaxItems {
+			return nil, fmt.Errorf("more than %d items", p.MaxItems)
+		}
+		var addresses []common.Address
+		seen := map[common.Address]bool{}
+		for i, item := range items {
+			v, err := p.Items.parse(item)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %v", i, err)
+			}
+			a := v.(common.Address)
+			if p.UniqueItems && seen[a] {
+				return nil, fmt.Errorf("item %d: duplicate %v", i, a.Hex())
+			}
+			seen[a] = true
+			addresses = append(addresses, a)
+		}
+		return addresses, nil
+	}
+	return nil, fmt.Errorf("unsupported parameter type %v", p.Type)
+}
+
+// TemplateContract returns the contract registered for t, registering it
+// on first use, and links it to the account.
+func TemplateContract(accountID string, t *Template, db *gorm.DB) (*Contract, error) {
+	return registerArtifact(accountID, t.Artifact(), db)
+}
+
+// registerArtifact returns the contract registered for a built in
+// artifact, registering it on first use, and links it to the account.
+func registerArtifact(accountID string, a *Artifact, db *gorm.DB) (*Contract, error) {
+	c := &Contract{}
+	if db.Where("bytecode = ?", a.Bytecode).First(c).RecordNotFound() {
+		var err error
+		if c, err = NewContractFromArtifact(a); err != nil {
+			return nil, err
+		}
+		if err := db.Create(c).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	m := &MyContract{}
+	if m.FindOrFalse(accountID, c.ID.String(), db) {
+		m = &MyContract{AccountID: accountID, ContractID: c.ID.String()}
+		if err := db.Create(m).Error; err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,756 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mislavio/contracter/testchain"
+)
+
+func TestTemplateConstructorArgs(t *testing.T) {
+	wallet := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tests := []struct {
+		template string
+		params   string
+		want     string
+		wantErr  string
+	}{
+		{
+			template: "erc20",
+			params:   `{"name": "Token", "symbol": "TKN"}`,
+			want:     `["Token","TKN",18,0,"0x00000000000000000000000000000000000000aa"]`,
+		},
+		{
+			template: "erc20",
+			params:   `{"name": "Token", "symbol": "TKN", "decimals": "6", "initialSupply": "1000.5", "owner": "0x00000000000000000000000000000000000000bb"}`,
+			want:     `["Token","TKN",6,1000500000,"0x00000000000000000000000000000000000000bb"]`,
+		},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "owner": null}`, want: `["Token","TKN",18,0,"0x00000000000000000000000000000000000000aa"]`},
+		{template: "erc20", params: `{"symbol": "TKN"}`, wantErr: "missing parameter name"},
+		{template: "erc20", params: `{"name": "", "symbol": "TKN"}`, wantErr: "name: shorter than 1 characters"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TOKENTOKENTOKENTOKEN"}`, wantErr: "symbol: longer than 16 characters"},
+		{template: "erc20", params: `{"name": 1, "symbol": "TKN"}`, wantErr: "name: expected a string"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "decimals": 37}`, wantErr: "decimals: greater than 36"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "decimals": -1}`, wantErr: "decimals: less than 0"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "decimals": 2, "initialSupply": "1.001"}`, wantErr: "initialSupply: amount 1.001 has more than 2 decimals"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "initialSupply": "1e6"}`, wantErr: "initialSupply: invalid amount"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "owner": "alice"}`, wantErr: "owner: invalid address"},
+		{template: "erc20", params: `{"name": "Token", "symbol": "TKN", "supply": "1", "cap": "2"}`, wantErr: "unknown parameters cap, supply"},
+		{template: "erc721", params: `{"name": "Art", "symbol": "ART"}`, want: `["Art","ART","","0x00000000000000000000000000000000000000aa"]`},
+		{template: "erc1155", params: `{"uri": "https://example.com/{id}.json"}`, want: `["https://example.com/{id}.json","0x00000000000000000000000000000000000000aa"]`},
+		{template: "erc1155", params: `{}`, wantErr: "missing parameter uri"},
+		{
+			template: "multisig",
+			params:   `{"owners": ["0x00000000000000000000000000000000000000bb", "0x00000000000000000000000000000000000000cc"], "threshold": 2}`,
+			want:     `["0xd9db270c1b5e3bd161e8c8503c55ceabee709552",["0x00000000000000000000000000000000000000bb","0x00000000000000000000000000000000000000cc"],2]`,
+		},
+		{
+			template: "multisig",
+			params:   `{"owners": ["0x00000000000000000000000000000000000000bb"], "threshold": 1, "singleton": "0x00000000000000000000000000000000000000dd"}`,
+			want:     `["0x00000000000000000000000000000000000000dd",["0x00000000000000000000000000000000000000bb"],1]`,
+		},
+		{template: "multisig", params: `{"owners": ["0x00000000000000000000000000000000000000bb"], "threshold": 2}`, wantErr: "threshold: 2 exceeds the 1 owners"},
+		{template: "multisig", params: `{"owners": ["0x00000000000000000000000000000000000000bb"], "threshold": 0}`, wantErr: "threshold: less than 1"},
+		{template: "multisig", params: `{"owners": [], "threshold": 1}`, wantErr: "owners: fewer than 1 items"},
+		{template: "multisig", params: `{"owners": "0x00000000000000000000000000000000000000bb", "threshold": 1}`, wantErr: "owners: expected an array"},
+		{template: "multisig", params: `{"owners": ["0x00000000000000000000000000000000000000bb", "0x00000000000000000000000000000000000000BB"], "threshold": 1}`, wantErr: "owners: item 1: duplicate"},
+		{template: "multisig", params: `{"owners": ["0x0000000000000000000000000000000000000000"], "threshold": 1}`, wantErr: "owners: zero address"},
+		{template: "multisig", params: `{"owners": ["bob"], "threshold": 1}`, wantErr: "owners: item 0: invalid address"},
+		{
+			template: "timelock",
+			params:   `{"minDelay": 3600}`,
+			want:     `[3600,["0x00000000000000000000000000000000000000aa"],["0x0000000000000000000000000000000000000000"],"0x0000000000000000000000000000000000000000"]`,
+		},
+		{template: "timelock", params: `{"minDelay": 3600, "owner": "0x0000000000000000000000000000000000000000"}`, wantErr: "owner: zero address"},
+		{template: "timelock", params: `{"minDelay": 1.5}`, wantErr: "minDelay"},
+	}
+	for _, tt := range tests {
+		tpl, ok := FindTemplate(tt.template)
+		if !ok {
+			t.Fatalf("no template %v", tt.template)
+		}
+		var params map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(tt.params), &params); err != nil {
+			t.Fatal(err)
+		}
+
+		args, err := tpl.ConstructorArgs(params, func() (common.Address, error) { return wallet, nil })
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%v %v: ConstructorArgs error = %v, want %q", tt.template, tt.params, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v %v: ConstructorArgs error = %v", tt.template, tt.params, err)
+			continue
+		}
+		got, _ := json.Marshal(args)
+		if string(got) != tt.want {
+			t.Errorf("%v %v: ConstructorArgs = %s, want %s", tt.template, tt.params, got, tt.want)
+		}
+
+		// The arguments encode for the constructor of the template.
+		parsed, err := abi.JSON(strings.NewReader(string(tpl.ABI())))
+		if err != nil {
+			t.Fatal(err)
+		}
+		values, err := ConvertArgs(parsed.Constructor.Inputs, args)
+		if err != nil {
+			t.Errorf("%v %v: ConvertArgs error = %v", tt.template, tt.params, err)
+			continue
+		}
+		if _, err := parsed.Pack("", values...); err != nil {
+			t.Errorf("%v %v: Pack error = %v", tt.template, tt.params, err)
+		}
+	}
+}
+
+func TestTemplateConstructorArgsWallet(t *testing.T) {
+	tpl, _ := FindTemplate("erc721")
+	params := map[string]json.RawMessage{"name": json.RawMessage(`"Art"`), "symbol": json.RawMessage(`"ART"`)}
+	walletErr := errors.New("no wallet")
+	if _, err := tpl.ConstructorArgs(params, func() (common.Address, error) { return common.Address{}, walletErr }); err != walletErr {
+		t.Errorf("ConstructorArgs error = %v, want the wallet error", err)
+	}
+
+	// The wallet is not needed when the owner is given.
+	params["owner"] = json.RawMessage(`"0x00000000000000000000000000000000000000bb"`)
+	if _, err := tpl.ConstructorArgs(params, func() (common.Address, error) { return common.Address{}, walletErr }); err != nil {
+		t.Errorf("ConstructorArgs error = %v", err)
+	}
+}
+
+func TestTemplateArtifacts(t *testing.T) {
+	for _, tpl := range Templates() {
+		a := tpl.Artifact()
+		if len(a.DeployedBytecode) == 0 || len(a.DeployedBytecode) >= len(a.Bytecode) {
+			t.Errorf("%v: runtime code of %d bytes in %d bytes of bytecode", tpl.Name, len(a.DeployedBytecode), len(a.Bytecode))
+		}
+		if a.CompilerVersion != "0.8.21+commit.d9974bed" || len(a.Metadata) == 0 {
+			t.Errorf("%v: compiler %q, %d bytes of metadata", tpl.Name, a.CompilerVersion, len(a.Metadata))
+		}
+		// The metadata hash in the runtime code matches the metadata.
+		if _, err := NewContractFromArtifact(a); err != nil {
+			t.Errorf("%v: NewContractFromArtifact error = %v", tpl.Name, err)
+		}
+		if _, err := abi.JSON(strings.NewReader(string(a.ABI))); err != nil {
+			t.Errorf("%v: %v", tpl.Name, err)
+		}
+		if _, err := json.Marshal(tpl.Schema()); err != nil {
+			t.Errorf("%v: %v", tpl.Name, err)
+		}
+	}
+
+	// The multisig ABI adds the functions of the Safe to the constructor of
+	// the proxy.
+	tpl, _ := FindTemplate("multisig")
+	parsed, err := abi.JSON(strings.NewReader(string(tpl.ABI())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(parsed.Constructor.Inputs); got != 3 {
+		t.Errorf("multisig constructor has %d inputs, want 3", got)
+	}
+	for _, name := range []string{"execTransaction", "getTransactionHash", "approveHash", "getOwners"} {
+		if _, ok := parsed.Methods[name]; !ok {
+			t.Errorf("multisig ABI has no %v", name)
+		}
+	}
+	safe := mustParseArtifact(safeArtifact)
+	if !bytes.HasSuffix(safe.Bytecode, safe.DeployedBytecode) {
+		t.Error("Safe bytecode does not end with its runtime code")
+	}
+}
+
+// templateGas is the gas limit of template transactions, fixed so
+// failing transactions are mined.
+const templateGas = 3000000
+
+// deployTemplate deploys the template name from account 0 with params
+// validated and encoded like a template deployment, defaulting the owner
+// to account 0.
+func deployTemplate(t *testing.T, c *testchain.Chain, name string, params map[string]interface{}) *templateContract {
+	tpl, _ := FindTemplate(name)
+	raw := map[string]json.RawMessage{}
+	for k, v := range params {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw[k] = b
+	}
+	rawArgs, err := tpl.ConstructorArgs(raw, func() (common.Address, error) { return c.Address(0), nil })
+	if err != nil {
+		t.Fatalf("%v: ConstructorArgs error = %v", name, err)
+	}
+	parsed := templateABI(t, tpl)
+	args, err := ConvertArgs(parsed.Constructor.Inputs, rawArgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, receipt := deployTemplateArgs(t, c, name, args...)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("deploying %v failed", name)
+	}
+	return m
+}
+
+// deployTemplateArgs deploys the template name from account 0 with
+// constructor arguments which are not validated.
+func deployTemplateArgs(t *testing.T, c *testchain.Chain, name string, args ...interface{}) (*templateContract, *types.Receipt) {
+	tpl, _ := FindTemplate(name)
+	parsed := templateABI(t, tpl)
+	ctorArgs, err := parsed.Pack("", args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, receipt := c.Deploy(0, templateGas, append(tpl.Artifact().Bytecode, ctorArgs...))
+	return &templateContract{t: t, c: c, tpl: tpl, abi: parsed, address: address}, receipt
+}
+
+// deployArtifact deploys the artifact in data from account 0.
+func deployArtifact(t *testing.T, c *testchain.Chain, data string) common.Address {
+	// The Safe singleton needs more than templateGas.
+	address, receipt := c.Deploy(0, 8000000, mustParseArtifact(data).Bytecode)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("deploying an artifact failed")
+	}
+	return address
+}
+
+func templateABI(t *testing.T, tpl *Template) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(string(tpl.ABI())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+// pay sends wei from account from to to and reports whether it succeeded.
+func pay(c *testchain.Chain, from int, to common.Address, wei *big.Int) bool {
+	return c.Send(from, templateGas, to, wei, nil).Status == types.ReceiptStatusSuccessful
+}
+
+// templateContract is a deployed template.
+type templateContract struct {
+	t       *testing.T
+	c       *testchain.Chain
+	tpl     *Template
+	abi     abi.ABI
+	address common.Address
+}
+
+func (m *templateContract) pack(method string, args ...interface{}) []byte {
+	data, err := m.abi.Pack(method, args...)
+	if err != nil {
+		m.t.Fatalf("packing %v: %v", method, err)
+	}
+	return data
+}
+
+// send calls method from account from with value and returns the receipt.
+func (m *templateContract) send(from int, value *big.Int, method string, args ...interface{}) *types.Receipt {
+	return m.c.Send(from, templateGas, m.address, value, m.pack(method, args...))
+}
+
+// ok calls method from account from and fails the test unless it succeeds.
+// It returns the names of the events emitted.
+func (m *templateContract) ok(from int, method string, args ...interface{}) []string {
+	m.t.Helper()
+	return m.okValue(from, nil, method, args...)
+}
+
+func (m *templateContract) okValue(from int, value *big.Int, method string, args ...interface{}) []string {
+	m.t.Helper()
+	receipt := m.send(from, value, method, args...)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		m.t.Fatalf("%v failed: %v", method, m.reason(from, value, method, args...))
+	}
+	var events []string
+	for _, l := range receipt.Logs {
+		events = append(events, DecodeLog(&m.abi, l).Name)
+	}
+	return events
+}
+
+// fails calls method from account from and fails the test unless it
+// reverts with reason.
+func (m *templateContract) fails(from int, reason string, method string, args ...interface{}) {
+	m.t.Helper()
+	if m.send(from, nil, method, args...).Status == types.ReceiptStatusSuccessful {
+		m.t.Errorf("%v succeeded, want %q", method, reason)
+		return
+	}
+	if got := m.reason(from, nil, method, args...); got != reason {
+		m.t.Errorf("%v revert reason = %q, want %q", method, got, reason)
+	}
+}
+
+// reason returns the revert reason of calling method from account from.
+func (m *templateContract) reason(from int, value *big.Int, method string, args ...interface{}) string {
+	msg := ethereum.CallMsg{From: m.c.Address(from), To: &m.address, Value: value, Data: m.pack(method, args...), Gas: templateGas}
+	out, err := m.c.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		return err.Error()
+	}
+	return DecodeRevert(string(m.tpl.ABI()), out).Message
+}
+
+// call returns the first output of the view method.
+func (m *templateContract) call(method string, args ...interface{}) interface{} {
+	m.t.Helper()
+	msg := ethereum.CallMsg{To: &m.address, Data: m.pack(method, args...)}
+	out, err := m.c.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	values, err := m.abi.Methods[method].Outputs.UnpackValues(out)
+	if err != nil || len(values) == 0 {
+		m.t.Fatalf("%v returned %x: %v", method, out, err)
+	}
+	return values[0]
+}
+
+// is fails the test unless method called with args returns want, compared
+// as formatted for JSON.
+func (m *templateContract) is(want interface{}, method string, args ...interface{}) {
+	m.t.Helper()
+	got := m.call(method, args...)
+	if n, ok := want.(int); ok {
+		want = big.NewInt(int64(n))
+	}
+	if fmt.Sprint(FormatValue(got)) != fmt.Sprint(FormatValue(want)) {
+		m.t.Errorf("%v%v = %v, want %v", method, FormatValues(args), FormatValue(got), FormatValue(want))
+	}
+}
+
+func wantEvents(t *testing.T, action string, got []string, want ...string) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%v emitted %v, want %v", action, got, want)
+	}
+}
+
+func TestERC20Template(t *testing.T) {
+	c := testchain.New(t, 30000000, 5, nil)
+	if _, receipt := deployTemplateArgs(t, c, "erc20", "Token", "TKN", uint8(18), big.NewInt(0), common.Address{}); receipt.Status != types.ReceiptStatusFailed {
+		t.Error("deploying with a zero owner succeeded")
+	}
+	m := deployTemplate(t, c, "erc20", map[string]interface{}{"name": "Token", "symbol": "TKN", "decimals": 2, "initialSupply": "10", "owner": c.Address(1)})
+	m.is("Token", "name")
+	m.is("TKN", "symbol")
+	m.is(uint8(2), "decimals")
+	m.is(1000, "totalSupply")
+	m.is(1000, "balanceOf", c.Address(1))
+	m.is(c.Address(1), "owner")
+
+	wantEvents(t, "transfer", m.ok(1, "transfer", c.Address(2), big.NewInt(300)), "Transfer")
+	m.is(700, "balanceOf", c.Address(1))
+	m.is(300, "balanceOf", c.Address(2))
+	m.fails(2, "ERC20: transfer amount exceeds balance", "transfer", c.Address(3), big.NewInt(301))
+	m.fails(2, "ERC20: transfer to the zero address", "transfer", common.Address{}, big.NewInt(1))
+
+	wantEvents(t, "approve", m.ok(2, "approve", c.Address(3), big.NewInt(100)), "Approval")
+	m.is(100, "allowance", c.Address(2), c.Address(3))
+	m.fails(3, "ERC20: insufficient allowance", "transferFrom", c.Address(2), c.Address(4), big.NewInt(101))
+	wantEvents(t, "transferFrom", m.ok(3, "transferFrom", c.Address(2), c.Address(4), big.NewInt(60)), "Approval", "Transfer")
+	m.is(40, "allowance", c.Address(2), c.Address(3))
+	m.is(60, "balanceOf", c.Address(4))
+	m.fails(2, "ERC20: approve to the zero address", "approve", common.Address{}, big.NewInt(1))
+	m.fails(2, "ERC20: decreased allowance below zero", "decreaseAllowance", c.Address(3), big.NewInt(41))
+	// An infinite allowance is not spent.
+	m.ok(2, "approve", c.Address(3), math.MaxBig256)
+	wantEvents(t, "transferFrom", m.ok(3, "transferFrom", c.Address(2), c.Address(4), big.NewInt(10)), "Transfer")
+	m.is(math.MaxBig256, "allowance", c.Address(2), c.Address(3))
+
+	m.fails(2, "Ownable: caller is not the owner", "mint", c.Address(2), big.NewInt(5))
+	m.fails(1, "ERC20: mint to the zero address", "mint", common.Address{}, big.NewInt(1))
+	wantEvents(t, "mint", m.ok(1, "mint", c.Address(2), big.NewInt(5)), "Transfer")
+	m.is(1005, "totalSupply")
+	m.fails(1, "arithmetic overflow or underflow", "mint", c.Address(2), math.MaxBig256)
+
+	wantEvents(t, "burn", m.ok(2, "burn", big.NewInt(35)), "Transfer")
+	m.is(970, "totalSupply")
+	m.is(200, "balanceOf", c.Address(2))
+	m.fails(2, "ERC20: burn amount exceeds balance", "burn", big.NewInt(201))
+	m.fails(4, "ERC20: insufficient allowance", "burnFrom", c.Address(2), big.NewInt(1))
+	m.ok(3, "burnFrom", c.Address(2), big.NewInt(1))
+	m.is(969, "totalSupply")
+
+	m.fails(2, "Ownable: caller is not the owner", "transferOwnership", c.Address(2))
+	m.fails(1, "Ownable: new owner is the zero address", "transferOwnership", common.Address{})
+	wantEvents(t, "transferOwnership", m.ok(1, "transferOwnership", c.Address(2)), "OwnershipTransferred")
+	m.is(c.Address(2), "owner")
+}
+
+func TestERC721Template(t *testing.T) {
+	c := testchain.New(t, 30000000, 5, nil)
+	base := "ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/"
+	m := deployTemplate(t, c, "erc721", map[string]interface{}{"name": "Collection", "symbol": "COL", "baseURI": base, "owner": c.Address(1)})
+	m.is("Collection", "name")
+	m.is("COL", "symbol")
+	m.is(c.Address(1), "owner")
+	for _, id := range [][4]byte{{0x01, 0xff, 0xc9, 0xa7}, InterfaceERC721, InterfaceERC721Metadata} {
+		m.is(true, "supportsInterface", id)
+	}
+	m.is(false, "supportsInterface", InterfaceERC1155)
+	m.is(false, "supportsInterface", [4]byte{0xff, 0xff, 0xff, 0xff})
+
+	m.fails(2, "Ownable: caller is not the owner", "mint", c.Address(2), big.NewInt(1))
+	wantEvents(t, "mint", m.ok(1, "mint", c.Address(2), big.NewInt(1)), "Transfer")
+	m.fails(1, "ERC721: token already minted", "mint", c.Address(3), big.NewInt(1))
+	m.fails(1, "ERC721: mint to the zero address", "mint", common.Address{}, big.NewInt(2))
+	m.ok(1, "mint", c.Address(2), big.NewInt(0))
+	m.ok(1, "mint", c.Address(2), math.MaxBig256)
+	m.is(3, "balanceOf", c.Address(2))
+	m.is(c.Address(2), "ownerOf", big.NewInt(1))
+	m.is(base+"0", "tokenURI", big.NewInt(0))
+	m.is(base+math.MaxBig256.String(), "tokenURI", math.MaxBig256)
+
+	m.fails(3, "ERC721: caller is not token owner or approved", "transferFrom", c.Address(2), c.Address(3), big.NewInt(1))
+	m.fails(2, "ERC721: transfer from incorrect owner", "transferFrom", c.Address(3), c.Address(3), big.NewInt(1))
+	m.fails(2, "ERC721: transfer to the zero address", "transferFrom", c.Address(2), common.Address{}, big.NewInt(1))
+	wantEvents(t, "transferFrom", m.ok(2, "transferFrom", c.Address(2), c.Address(3), big.NewInt(1)), "Transfer")
+	m.is(c.Address(3), "ownerOf", big.NewInt(1))
+	m.is(2, "balanceOf", c.Address(2))
+
+	m.fails(3, "ERC721: approval to current owner", "approve", c.Address(3), big.NewInt(1))
+	wantEvents(t, "approve", m.ok(3, "approve", c.Address(4), big.NewInt(1)), "Approval")
+	m.is(c.Address(4), "getApproved", big.NewInt(1))
+	m.ok(4, "transferFrom", c.Address(3), c.Address(4), big.NewInt(1))
+	m.is(common.Address{}, "getApproved", big.NewInt(1))
+	m.fails(2, "ERC721: approve to caller", "setApprovalForAll", c.Address(2), true)
+	wantEvents(t, "setApprovalForAll", m.ok(2, "setApprovalForAll", c.Address(3), true), "ApprovalForAll")
+	m.is(true, "isApprovedForAll", c.Address(2), c.Address(3))
+	m.ok(3, "transferFrom", c.Address(2), c.Address(3), big.NewInt(0))
+	m.ok(2, "setApprovalForAll", c.Address(3), false)
+	m.fails(3, "ERC721: caller is not token owner or approved", "transferFrom", c.Address(2), c.Address(3), math.MaxBig256)
+
+	echo := deployArtifact(t, c, echoReceiverArtifact)
+	m.ok(2, "safeTransferFrom0", c.Address(2), echo, math.MaxBig256, []byte("hello"))
+	m.is(echo, "ownerOf", math.MaxBig256)
+	m.ok(3, "safeTransferFrom", c.Address(3), c.Address(4), big.NewInt(0))
+	m.ok(1, "safeMint", echo, big.NewInt(77))
+	m.is(echo, "ownerOf", big.NewInt(77))
+	m.ok(1, "mint", c.Address(2), big.NewInt(2))
+	receivers := []struct {
+		artifact string
+		reason   string
+	}{
+		{garbageReceiverArtifact, "ERC721: transfer to non ERC721Receiver implementer"},
+		{reverterReceiverArtifact, "receiver says no"},
+		{silentReceiverArtifact, "ERC721: transfer to non ERC721Receiver implementer"},
+	}
+	for _, r := range receivers {
+		receiver := deployArtifact(t, c, r.artifact)
+		m.fails(2, r.reason, "safeTransferFrom0", c.Address(2), receiver, big.NewInt(2), []byte{})
+		m.fails(1, r.reason, "safeMint", receiver, big.NewInt(78))
+	}
+	m.fails(2, "ERC721: transfer to non ERC721Receiver implementer", "safeTransferFrom", c.Address(2), m.address, big.NewInt(2))
+
+	m.fails(3, "ERC721: caller is not token owner or approved", "burn", big.NewInt(2))
+	wantEvents(t, "burn", m.ok(2, "burn", big.NewInt(2)), "Transfer")
+	m.fails(2, "ERC721: invalid token ID", "burn", big.NewInt(2))
+	m.is(0, "balanceOf", c.Address(2))
+
+	m = deployTemplate(t, c, "erc721", map[string]interface{}{"name": "Collection", "symbol": "COL"})
+	m.ok(0, "mint", c.Address(2), big.NewInt(1))
+	m.is("", "tokenURI", big.NewInt(1))
+}
+
+func TestERC1155Template(t *testing.T) {
+	c := testchain.New(t, 30000000, 5, nil)
+	m := deployTemplate(t, c, "erc1155", map[string]interface{}{"uri": "https://example.com/{id}.json"})
+	m.is("https://example.com/{id}.json", "uri", big.NewInt(5))
+	m.is(c.Address(0), "owner")
+	for _, id := range [][4]byte{{0x01, 0xff, 0xc9, 0xa7}, InterfaceERC1155, InterfaceERC1155URI} {
+		m.is(true, "supportsInterface", id)
+	}
+	m.is(false, "supportsInterface", InterfaceERC721)
+
+	ints := func(v ...int64) []*big.Int {
+		var out []*big.Int
+		for _, n := range v {
+			out = append(out, big.NewInt(n))
+		}
+		return out
+	}
+	m.fails(1, "Ownable: caller is not the owner", "mint", c.Address(1), big.NewInt(1), big.NewInt(10), []byte{})
+	wantEvents(t, "mint", m.ok(0, "mint", c.Address(1), big.NewInt(1), big.NewInt(10), []byte{}), "TransferSingle")
+	wantEvents(t, "mintBatch", m.ok(0, "mintBatch", c.Address(1), ints(2, 3, 2), ints(5, 7, 1), []byte{1, 2}), "TransferBatch")
+	m.is(ints(10, 6, 7, 0), "balanceOfBatch", []common.Address{c.Address(1), c.Address(1), c.Address(1), c.Address(2)}, ints(1, 2, 3, 1))
+	m.fails(0, "ERC1155: ids and amounts length mismatch", "mintBatch", c.Address(1), ints(2, 3), ints(5), []byte{})
+
+	m.fails(2, "ERC1155: caller is not token owner or approved", "safeTransferFrom", c.Address(1), c.Address(2), big.NewInt(1), big.NewInt(1), []byte{})
+	m.fails(1, "ERC1155: insufficient balance for transfer", "safeTransferFrom", c.Address(1), c.Address(2), big.NewInt(1), big.NewInt(11), []byte{})
+	m.fails(1, "ERC1155: transfer to the zero address", "safeTransferFrom", c.Address(1), common.Address{}, big.NewInt(1), big.NewInt(1), []byte{})
+	wantEvents(t, "safeTransferFrom", m.ok(1, "safeTransferFrom", c.Address(1), c.Address(2), big.NewInt(1), big.NewInt(4), []byte{}), "TransferSingle")
+	m.is(6, "balanceOf", c.Address(1), big.NewInt(1))
+	m.is(4, "balanceOf", c.Address(2), big.NewInt(1))
+	m.fails(1, "ERC1155: setting approval status for self", "setApprovalForAll", c.Address(1), true)
+	wantEvents(t, "setApprovalForAll", m.ok(1, "setApprovalForAll", c.Address(3), true), "ApprovalForAll")
+	wantEvents(t, "safeBatchTransferFrom", m.ok(3, "safeBatchTransferFrom", c.Address(1), c.Address(2), ints(2, 3), ints(6, 7), []byte{}), "TransferBatch")
+	m.is(ints(0, 6), "balanceOfBatch", []common.Address{c.Address(1), c.Address(2)}, ints(2, 2))
+	m.ok(1, "setApprovalForAll", c.Address(3), false)
+	m.fails(3, "ERC1155: caller is not token owner or approved", "safeTransferFrom", c.Address(1), c.Address(2), big.NewInt(1), big.NewInt(1), []byte{})
+
+	echo := deployArtifact(t, c, echoReceiverArtifact)
+	m.ok(2, "safeTransferFrom", c.Address(2), echo, big.NewInt(1), big.NewInt(2), []byte("hello"))
+	m.ok(2, "safeBatchTransferFrom", c.Address(2), echo, ints(1, 2), ints(1, 3), []byte("hello"))
+	m.ok(0, "mintBatch", echo, ints(9), ints(1), []byte{})
+	m.is(ints(3, 3, 1), "balanceOfBatch", []common.Address{echo, echo, echo}, ints(1, 2, 9))
+	receivers := []struct {
+		artifact string
+		reason   string
+	}{
+		{garbageReceiverArtifact, "ERC1155: ERC1155Receiver rejected tokens"},
+		{reverterReceiverArtifact, "receiver says no"},
+		{silentReceiverArtifact, "ERC1155: transfer to non-ERC1155Receiver implementer"},
+	}
+	for _, r := range receivers {
+		receiver := deployArtifact(t, c, r.artifact)
+		m.fails(2, r.reason, "safeTransferFrom", c.Address(2), receiver, big.NewInt(1), big.NewInt(1), []byte{})
+		m.fails(2, r.reason, "safeBatchTransferFrom", c.Address(2), receiver, ints(1), ints(1), []byte{})
+		m.fails(0, r.reason, "mint", receiver, big.NewInt(1), big.NewInt(1), []byte{})
+	}
+
+	m.fails(3, "ERC1155: caller is not token owner or approved", "burn", c.Address(1), big.NewInt(1), big.NewInt(1))
+	m.fails(1, "ERC1155: burn amount exceeds balance", "burn", c.Address(1), big.NewInt(1), big.NewInt(7))
+	wantEvents(t, "burn", m.ok(1, "burn", c.Address(1), big.NewInt(1), big.NewInt(6)), "TransferSingle")
+	m.is(0, "balanceOf", c.Address(1), big.NewInt(1))
+	wantEvents(t, "burnBatch", m.ok(2, "burnBatch", c.Address(2), ints(2, 1), ints(1, 1)), "TransferBatch")
+	m.is(ints(2, 0), "balanceOfBatch", []common.Address{c.Address(2), c.Address(2)}, ints(2, 1))
+}
+
+// safeSignatures returns pre-approved hash signatures of owners, sorted by
+// address as the Safe requires. Each owner is the sender of the
+// transaction or approved its hash with approveHash.
+func safeSignatures(owners ...common.Address) []byte {
+	sorted := append([]common.Address{}, owners...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0 })
+	var signatures []byte
+	for _, o := range sorted {
+		signatures = append(signatures, common.LeftPadBytes(o.Bytes(), 32)...)
+		signatures = append(signatures, make([]byte, 32)...)
+		signatures = append(signatures, 1)
+	}
+	return signatures
+}
+
+func TestMultisigTemplate(t *testing.T) {
+	c := testchain.New(t, 30000000, 5, nil)
+	singleton := deployArtifact(t, c, safeArtifact)
+	owners := []common.Address{c.Address(0), c.Address(1), c.Address(2)}
+	invalid := []struct {
+		singleton common.Address
+		owners    []common.Address
+		threshold int64
+	}{
+		{singleton, owners, 0},
+		{singleton, owners, 4},
+		{singleton, []common.Address{c.Address(0), c.Address(0)}, 1},
+		{singleton, []common.Address{{}}, 1},
+		{singleton, nil, 1},
+		{c.Address(3), owners, 1},
+		{common.Address{}, owners, 1},
+	}
+	for _, tt := range invalid {
+		if _, receipt := deployTemplateArgs(t, c, "multisig", tt.singleton, tt.owners, big.NewInt(tt.threshold)); receipt.Status != types.ReceiptStatusFailed {
+			t.Errorf("deploying with singleton %v, owners %v and threshold %d succeeded", tt.singleton.Hex(), tt.owners, tt.threshold)
+		}
+	}
+	m := deployTemplate(t, c, "multisig", map[string]interface{}{"owners": owners, "threshold": 2, "singleton": singleton})
+	m.is("1.3.0", "VERSION")
+	m.is(owners, "getOwners")
+	m.is(true, "isOwner", c.Address(1))
+	m.is(false, "isOwner", c.Address(3))
+	m.is(2, "getThreshold")
+	// The proxy keeps the singleton in its first storage slot.
+	slot, err := c.StorageAt(context.Background(), m.address, common.Hash{}, nil)
+	if err != nil || common.BytesToAddress(slot) != singleton {
+		t.Errorf("singleton = %x, %v, want %v", slot, err, singleton.Hex())
+	}
+	if !pay(c, 3, m.address, big.NewInt(5e18)) {
+		t.Fatal("depositing failed")
+	}
+
+	// exec returns the arguments of execTransaction for a call without
+	// refunds.
+	exec := func(to common.Address, value *big.Int, data, signatures []byte) []interface{} {
+		return []interface{}{to, value, data, uint8(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, common.Address{}, signatures}
+	}
+	txHash := func(to common.Address, value *big.Int, data []byte, nonce int64) [32]byte {
+		return m.call("getTransactionHash", to, value, data, uint8(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), common.Address{}, common.Address{}, big.NewInt(nonce)).([32]byte)
+	}
+
+	data := []byte("call data longer than thirty two bytes")
+	hash := txHash(c.Address(4), big.NewInt(1e18), data, 0)
+	word := func(b []byte) []byte { return common.LeftPadBytes(b, 32) }
+	domain := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(uint256 chainId,address verifyingContract)")),
+		word(big.NewInt(1337).Bytes()),
+		word(m.address.Bytes()),
+	)
+	safeTx := crypto.Keccak256(
+		crypto.Keccak256([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)")),
+		word(c.Address(4).Bytes()),
+		word(big.NewInt(1e18).Bytes()),
+		crypto.Keccak256(data),
+		// Operation, gas, refund and nonce are all zero.
+		make([]byte, 7*32),
+	)
+	if want := crypto.Keccak256Hash([]byte{0x19, 0x01}, domain, safeTx); common.Hash(hash) != want {
+		t.Errorf("getTransactionHash() = %x, want %x", hash, want)
+	}
+
+	m.fails(3, "GS030", "approveHash", hash)
+	wantEvents(t, "approveHash", m.ok(0, "approveHash", hash), "ApproveHash")
+	m.is(1, "approvedHashes", c.Address(0), hash)
+	signatures := safeSignatures(c.Address(0), c.Address(1))
+	m.fails(3, "GS025", "execTransaction", exec(c.Address(4), big.NewInt(1e18), data, signatures)...)
+	m.fails(1, "GS020", "execTransaction", exec(c.Address(4), big.NewInt(1e18), data, signatures[:65])...)
+	unsorted := append(append([]byte{}, signatures[65:]...), signatures[:65]...)
+	m.fails(1, "GS026", "execTransaction", exec(c.Address(4), big.NewInt(1e18), data, unsorted)...)
+
+	before := c.Balance(c.Address(4))
+	wantEvents(t, "execTransaction", m.ok(1, "execTransaction", exec(c.Address(4), big.NewInt(1e18), data, signatures)...), "ExecutionSuccess")
+	if got := new(big.Int).Sub(c.Balance(c.Address(4)), before); got.Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("execTransaction() paid %v, want 1e18", got)
+	}
+	m.is(1, "nonce")
+	// The approval was for the old nonce.
+	m.fails(1, "GS025", "execTransaction", exec(c.Address(4), big.NewInt(1e18), data, signatures)...)
+
+	token := deployTemplate(t, c, "erc20", map[string]interface{}{"name": "Token", "symbol": "TKN", "decimals": 0, "initialSupply": "100", "owner": m.address})
+	transfer := token.pack("transfer", c.Address(4), big.NewInt(40))
+	m.ok(0, "approveHash", txHash(token.address, big.NewInt(0), transfer, 1))
+	m.ok(2, "execTransaction", exec(token.address, big.NewInt(0), transfer, safeSignatures(c.Address(0), c.Address(2)))...)
+	token.is(40, "balanceOf", c.Address(4))
+
+	// A failing call reverts the transaction without using the nonce.
+	transfer = token.pack("transfer", c.Address(4), big.NewInt(400))
+	m.ok(0, "approveHash", txHash(token.address, big.NewInt(0), transfer, 2))
+	m.fails(1, "GS013", "execTransaction", exec(token.address, big.NewInt(0), transfer, signatures)...)
+	m.is(2, "nonce")
+
+	// Owners are changed by the Safe itself.
+	m.fails(0, "GS031", "addOwnerWithThreshold", c.Address(3), big.NewInt(3))
+	add := m.pack("addOwnerWithThreshold", c.Address(3), big.NewInt(3))
+	m.ok(0, "approveHash", txHash(m.address, big.NewInt(0), add, 2))
+	wantEvents(t, "execTransaction", m.ok(1, "execTransaction", exec(m.address, big.NewInt(0), add, signatures)...), "AddedOwner", "ChangedThreshold", "ExecutionSuccess")
+	m.is(3, "getThreshold")
+	m.is([]common.Address{c.Address(3), c.Address(0), c.Address(1), c.Address(2)}, "getOwners")
+}
+
+func TestTimelockTemplate(t *testing.T) {
+	c := testchain.New(t, 30000000, 5, nil)
+	m := deployTemplate(t, c, "timelock", map[string]interface{}{"minDelay": 3600})
+	m.is(3600, "getMinDelay")
+	proposer := m.call("PROPOSER_ROLE").([32]byte)
+	canceller := m.call("CANCELLER_ROLE").([32]byte)
+	executor := m.call("EXECUTOR_ROLE").([32]byte)
+	admin := m.call("TIMELOCK_ADMIN_ROLE").([32]byte)
+	m.is(true, "hasRole", proposer, c.Address(0))
+	m.is(true, "hasRole", canceller, c.Address(0))
+	// Anyone may execute and only the timelock administers roles.
+	m.is(true, "hasRole", executor, common.Address{})
+	m.is(true, "hasRole", admin, m.address)
+	m.is(false, "hasRole", admin, c.Address(0))
+	if !pay(c, 3, m.address, big.NewInt(5e18)) {
+		t.Fatal("depositing failed")
+	}
+	missingRole := func(account common.Address, role [32]byte) string {
+		return fmt.Sprintf("AccessControl: account %v is missing role %v", strings.ToLower(account.Hex()), hexutil.Encode(role[:]))
+	}
+
+	var none [32]byte
+	salt := [32]byte{31: 7}
+	data := []byte("thirty three bytes of call data!!")
+	id := m.call("hashOperation", c.Address(4), big.NewInt(1e18), data, none, salt).([32]byte)
+	args := abi.Arguments{{Type: mustNewType(t, "address")}, {Type: mustNewType(t, "uint256")}, {Type: mustNewType(t, "bytes")}, {Type: mustNewType(t, "bytes32")}, {Type: mustNewType(t, "bytes32")}}
+	enc, err := args.Pack(c.Address(4), big.NewInt(1e18), data, none, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.Keccak256Hash(enc); common.Hash(id) != want {
+		t.Errorf("hashOperation() = %x, want %x", id, want)
+	}
+
+	m.fails(1, missingRole(c.Address(1), proposer), "schedule", c.Address(4), big.NewInt(1e18), data, none, salt, big.NewInt(3600))
+	m.fails(0, "TimelockController: insufficient delay", "schedule", c.Address(4), big.NewInt(1e18), data, none, salt, big.NewInt(3599))
+	wantEvents(t, "schedule", m.ok(0, "schedule", c.Address(4), big.NewInt(1e18), data, none, salt, big.NewInt(3600)), "CallScheduled", "CallSalt")
+	m.fails(0, "TimelockController: operation already scheduled", "schedule", c.Address(4), big.NewInt(1e18), data, none, salt, big.NewInt(3600))
+	m.is(true, "isOperationPending", id)
+	m.is(false, "isOperationReady", id)
+	m.fails(1, "TimelockController: operation is not ready", "execute", c.Address(4), big.NewInt(1e18), data, none, salt)
+
+	c.Skip(3601 * time.Second)
+	m.is(true, "isOperationReady", id)
+	m.fails(1, "TimelockController: operation is not ready", "execute", c.Address(4), big.NewInt(1e18), data, none, none)
+	before := c.Balance(c.Address(4))
+	wantEvents(t, "execute", m.ok(1, "execute", c.Address(4), big.NewInt(1e18), data, none, salt), "CallExecuted")
+	if got := new(big.Int).Sub(c.Balance(c.Address(4)), before); got.Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("execute() paid %v, want 1e18", got)
+	}
+	m.is(true, "isOperationDone", id)
+	m.fails(1, "TimelockController: operation is not ready", "execute", c.Address(4), big.NewInt(1e18), data, none, salt)
+	m.fails(0, "TimelockController: operation cannot be cancelled", "cancel", id)
+
+	m.ok(0, "schedule", c.Address(4), big.NewInt(1), []byte{}, none, salt, big.NewInt(4000))
+	id = m.call("hashOperation", c.Address(4), big.NewInt(1), []byte{}, none, salt).([32]byte)
+	m.fails(1, missingRole(c.Address(1), canceller), "cancel", id)
+	wantEvents(t, "cancel", m.ok(0, "cancel", id), "Cancelled")
+	m.is(false, "isOperation", id)
+
+	m.fails(0, "TimelockController: caller must be timelock", "updateDelay", big.NewInt(10))
+	update := m.pack("updateDelay", big.NewInt(10))
+	m.ok(0, "schedule", m.address, big.NewInt(0), update, none, salt, big.NewInt(3600))
+	c.Skip(3601 * time.Second)
+	wantEvents(t, "execute", m.ok(2, "execute", m.address, big.NewInt(0), update, none, salt), "MinDelayChange", "CallExecuted")
+	m.is(10, "getMinDelay")
+
+	// A failing call reverts the execution, the timelock cannot cancel.
+	cancel := m.pack("cancel", salt)
+	m.ok(0, "schedule", m.address, big.NewInt(0), cancel, none, salt, big.NewInt(10))
+	c.Skip(11 * time.Second)
+	m.fails(0, "TimelockController: underlying transaction reverted", "execute", m.address, big.NewInt(0), cancel, none, salt)
+
+	// Operations wait for their predecessor.
+	first := m.call("hashOperation", c.Address(3), big.NewInt(0), []byte{}, none, [32]byte{3}).([32]byte)
+	m.ok(0, "schedule", c.Address(3), big.NewInt(0), []byte{}, none, [32]byte{3}, big.NewInt(10))
+	m.ok(0, "schedule", c.Address(3), big.NewInt(0), []byte{}, first, [32]byte{4}, big.NewInt(10))
+	c.Skip(11 * time.Second)
+	m.fails(1, "TimelockController: missing dependency", "execute", c.Address(3), big.NewInt(0), []byte{}, first, [32]byte{4})
+	m.ok(1, "execute", c.Address(3), big.NewInt(0), []byte{}, none, [32]byte{3})
+	m.ok(1, "execute", c.Address(3), big.NewInt(0), []byte{}, first, [32]byte{4})
+
+	// Value sent with execute is forwarded.
+	m.ok(0, "schedule", c.Address(3), big.NewInt(2e18), []byte{}, none, [32]byte{2}, big.NewInt(10))
+	c.Skip(11 * time.Second)
+	m.okValue(4, big.NewInt(1e18), "execute", c.Address(3), big.NewInt(2e18), []byte{}, none, [32]byte{2})
+}
+
+func mustNewType(t *testing.T, name string) abi.Type {
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return typ
+}
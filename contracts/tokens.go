@@ -0,0 +1,327 @@
+package contracts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// AmountMax approves the largest possible allowance.
+const AmountMax = "max"
+
+// StandardERC20 is the fungible token standard.
+const StandardERC20 = "erc20"
+
+// erc20Functions are the functions EIP-20 requires with their outputs.
+// transfer, transferFrom and approve may return nothing, as early tokens
+// such as USDT do.
+var erc20Functions = map[string]string{
+	"totalSupply()":                         "uint256",
+	"balanceOf(address)":                    "uint256",
+	"allowance(address,address)":            "uint256",
+	"transfer(address,uint256)":             "bool",
+	"transferFrom(address,address,uint256)": "bool",
+	"approve(address,uint256)":              "bool",
+}
+
+// erc20Optional are the optional metadata functions with the outputs they
+// may have. Some early tokens return name and symbol as bytes32.
+var erc20Optional = map[string][]string{
+	"name()":     {"string", "bytes32"},
+	"symbol()":   {"string", "bytes32"},
+	"decimals()": {"uint8", "uint256"},
+}
+
+var erc20Events = []string{
+	"Transfer(address,address,uint256)",
+	"Approval(address,address,uint256)",
+}
+
+var amountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// CheckERC20 returns an error listing every way parsed deviates from the
+// functions and events EIP-20 requires.
+func CheckERC20(parsed abi.ABI) error {
+	methods := map[string]abi.Method{}
+	for _, m := range parsed.Methods {
+		methods[m.Sig()] = m
+	}
+	events := map[string]bool{}
+	for _, e := range parsed.Events {
+		events[e.Sig()] = true
+	}
+
+	var problems []string
+	for sig, output := range erc20Functions {
+		m, ok := methods[sig]
+		switch {
+		case !ok:
+			problems = append(problems, "missing "+sig)
+		case output == "bool" && len(m.Outputs) == 0:
+		case len(m.Outputs) != 1 || m.Outputs[0].Type.String() != output:
+			problems = append(problems, fmt.Sprintf("%v must return %v", sig, output))
+		}
+	}
+	for sig, outputs := range erc20Optional {
+		m, ok := methods[sig]
+		if ok && (len(m.Outputs) != 1 || !contains(outputs, m.Outputs[0].Type.String())) {
+			problems = append(problems, fmt.Sprintf("%v must return %v", sig, strings.Join(outputs, " or ")))
+		}
+	}
+	for _, sig := range erc20Events {
+		if !events[sig] {
+			problems = append(problems, "missing event "+sig)
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.New("not an ERC-20 token: " + strings.Join(problems, ", "))
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseUnits converts a decimal amount such as 1.5 to base units of a
+// token with the given decimals. AmountMax is the largest uint256.
+func ParseUnits(amount string, decimals uint8) (*big.Int, error) {
+	if amount == AmountMax {
+		return new(big.Int).Set(math.MaxBig256), nil
+	}
+	if !amountPattern.MatchString(amount) {
+		return nil, fmt.Errorf("invalid amount %q, expected a decimal number such as 1.5", amount)
+	}
+	parts := strings.SplitN(amount, ".", 2)
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = strings.TrimRight(parts[1], "0")
+	}
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("amount %v has more than %d decimals", amount, decimals)
+	}
+
+	v, _ := new(big.Int).SetString(parts[0]+fraction+strings.Repeat("0", int(decimals)-len(fraction)), 10)
+	if v.Cmp(math.MaxBig256) > 0 {
+		return nil, fmt.Errorf("amount %v does not fit into uint256", amount)
+	}
+	return v, nil
+}
+
+// FormatUnits converts base units of a token with the given decimals to a
+// decimal amount without trailing zeros.
+func FormatUnits(v *big.Int, decimals uint8) string {
+	s := v.String()
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	whole, fraction := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if fraction == "" {
+		return whole
+	}
+	return whole + "." + fraction
+}
+
+// Token is an ERC-20 contract linked to an account at its address on a
+// network.
+type Token struct {
+	Contract *Contract
+	Network  string
+	Address  common.Address
+	ABI      abi.ABI
+}
+
+// LoadToken returns the ERC-20 token of a contract linked to the account
+// at the address deployedAddress picks on network.
+func LoadToken(accountID string, id string, network string, db *gorm.DB) (*Token, error) {
+	m := &MyContract{}
+	if _, err := uuid.FromString(id); err != nil || m.FindOrFalse(accountID, id, db) {
+		return nil, helpers.ErrNotFound("contract", id)
+	}
+	c := &m.Contract
+
+	parsed, err := abi.JSON(strings.NewReader(string(c.ABI.RawMessage)))
+	if err != nil {
+		return nil, helpers.ErrABIInvalid(err)
+	}
+	if err := CheckERC20(parsed); err != nil {
+		return nil, helpers.ErrNotERC20(err)
+	}
+
+	network, address, err := c.deployedAddress(accountID, network, db)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{Contract: c, Network: network, Address: address, ABI: parsed}, nil
+}
+
+// deployedAddress returns where c is deployed: its own address, if it has
+// one on network, or the latest successful deployment by the account, on
+// network if given.
+func (c *Contract) deployedAddress(accountID string, network string, db *gorm.DB) (string, common.Address, error) {
+	if c.Address != "" && (network == "" || network == c.Network) {
+		return c.Network, common.HexToAddress(c.Address), nil
+	}
+
+	q := db.Where("contract_id = ? AND account_id = ? AND status = ?", c.ID.String(), accountID, TxSuccess)
+	if network != "" {
+		q = q.Where("network = ?", network)
+	}
+	d := &Deployment{}
+	if q.Order("created_at desc").First(d).RecordNotFound() {
+		if network != "" {
+			return "", common.Address{}, helpers.ErrBadRequest(fmt.Errorf("contract is not deployed on %v", network))
+		}
+		return "", common.Address{}, helpers.ErrBadRequest(errors.New("contract has no successful deployment"))
+	}
+	return d.Network, common.HexToAddress(d.Address), nil
+}
+
+// TokenMetadata is the metadata of an ERC-20 token. Name, symbol and
+// decimals are optional in EIP-20 and left empty when the token lacks
+// them, amounts of tokens without decimals are in base units.
+type TokenMetadata struct {
+	ContractID     string `json:"contractId"`
+	Network        string `json:"network"`
+	Address        string `json:"address"`
+	Name           string `json:"name,omitempty"`
+	Symbol         string `json:"symbol,omitempty"`
+	Decimals       uint8  `json:"decimals"`
+	TotalSupply    string `json:"totalSupply"`
+	TotalSupplyRaw string `json:"totalSupplyRaw"`
+}
+
+// Metadata reads the metadata and total supply of t.
+func (t *Token) Metadata(ctx context.Context, client *Client) (*TokenMetadata, error) {
+	decimals, err := t.Decimals(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := t.uint256(ctx, client, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+
+	md := &TokenMetadata{
+		ContractID:     t.Contract.ID.String(),
+		Network:        t.Network,
+		Address:        t.Address.Hex(),
+		Decimals:       decimals,
+		TotalSupply:    FormatUnits(supply, decimals),
+		TotalSupplyRaw: supply.String(),
+	}
+	if md.Name, err = t.text(ctx, client, "name"); err != nil {
+		return nil, err
+	}
+	if md.Symbol, err = t.text(ctx, client, "symbol"); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+// Decimals returns the decimals of t, or 0 if it does not declare them.
+func (t *Token) Decimals(ctx context.Context, client *Client) (uint8, error) {
+	if _, ok := t.ABI.Methods["decimals"]; !ok {
+		return 0, nil
+	}
+	values, err := t.call(ctx, client, "decimals")
+	if err != nil {
+		return 0, err
+	}
+	switch v := values[0].(type) {
+	case uint8:
+		return v, nil
+	case *big.Int:
+		if v.IsUint64() && v.Uint64() <= 255 {
+			return uint8(v.Uint64()), nil
+		}
+	}
+	return 0, fmt.Errorf("token returned invalid decimals %v", values[0])
+}
+
+// BalanceOf returns the balance of owner in base units.
+func (t *Token) BalanceOf(ctx context.Context, client *Client, owner common.Address) (*big.Int, error) {
+	return t.uint256(ctx, client, "balanceOf", owner)
+}
+
+// Allowance returns how much spender may transfer from owner in base units.
+func (t *Token) Allowance(ctx context.Context, client *Client, owner common.Address, spender common.Address) (*big.Int, error) {
+	return t.uint256(ctx, client, "allowance", owner, spender)
+}
+
+func (t *Token) uint256(ctx context.Context, client *Client, method string, args ...interface{}) (*big.Int, error) {
+	values, err := t.call(ctx, client, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].(*big.Int), nil
+}
+
+// text returns the string or bytes32 result of an optional method.
+func (t *Token) text(ctx context.Context, client *Client, method string) (string, error) {
+	if _, ok := t.ABI.Methods[method]; !ok {
+		return "", nil
+	}
+	values, err := t.call(ctx, client, method)
+	if err != nil {
+		return "", err
+	}
+	switch v := values[0].(type) {
+	case string:
+		return v, nil
+	case [32]byte:
+		return strings.TrimRight(string(v[:]), "\x00"), nil
+	}
+	return "", nil
+}
+
+// call executes a view method of t at the latest block.
+func (t *Token) call(ctx context.Context, client *Client, method string, args ...interface{}) ([]interface{}, error) {
+	return callView(ctx, client, t.Address, t.ABI, string(t.Contract.ABI.RawMessage), method, args...)
+}
+
+// callView executes a view method of the contract at to at the latest
+// block and decodes its outputs. Reverts are decoded with abiJSON.
+func callView(ctx context.Context, client *Client, to common.Address, parsed abi.ABI, abiJSON string, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		if reason, ok := RevertFromError(err, abiJSON); ok {
+			return nil, helpers.ErrExecutionReverted(reason)
+		}
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%v returned no data, %v has no code or does not implement it", method, to.Hex())
+	}
+	values, err := parsed.Methods[method].Outputs.UnpackValues(out)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", method, err)
+	}
+	return values, nil
+}
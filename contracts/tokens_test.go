@@ -0,0 +1,73 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals uint8
+		want     string
+		wantErr  bool
+	}{
+		{amount: "1", decimals: 18, want: "1000000000000000000"},
+		{amount: "1.5", decimals: 18, want: "1500000000000000000"},
+		{amount: "0.000001", decimals: 6, want: "1"},
+		{amount: "1.10", decimals: 1, want: "11"},
+		{amount: "007", decimals: 2, want: "700"},
+		{amount: "42", decimals: 0, want: "42"},
+		{amount: "0", decimals: 18, want: "0"},
+		{amount: AmountMax, decimals: 18, want: math.MaxBig256.String()},
+		{amount: math.MaxBig256.String(), decimals: 0, want: math.MaxBig256.String()},
+		{amount: "0.0000001", decimals: 6, wantErr: true},
+		{amount: "1.5", decimals: 0, wantErr: true},
+		{amount: math.MaxBig256.String(), decimals: 1, wantErr: true},
+		{amount: "-1", decimals: 18, wantErr: true},
+		{amount: "1e18", decimals: 18, wantErr: true},
+		{amount: ".5", decimals: 18, wantErr: true},
+		{amount: "1.", decimals: 18, wantErr: true},
+		{amount: "0x10", decimals: 18, wantErr: true},
+		{amount: "", decimals: 18, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.amount, tt.decimals)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseUnits(%q, %d) error = %v, wantErr %v", tt.amount, tt.decimals, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseUnits(%q, %d) = %v, want %v", tt.amount, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		v        string
+		decimals uint8
+		want     string
+	}{
+		{v: "1000000000000000000", decimals: 18, want: "1"},
+		{v: "1500000000000000000", decimals: 18, want: "1.5"},
+		{v: "1", decimals: 6, want: "0.000001"},
+		{v: "123456", decimals: 6, want: "0.123456"},
+		{v: "1234567", decimals: 6, want: "1.234567"},
+		{v: "0", decimals: 18, want: "0"},
+		{v: "42", decimals: 0, want: "42"},
+		{v: "100", decimals: 2, want: "1"},
+	}
+	for _, tt := range tests {
+		v, _ := new(big.Int).SetString(tt.v, 10)
+		if got := FormatUnits(v, tt.decimals); got != tt.want {
+			t.Errorf("FormatUnits(%v, %d) = %v, want %v", tt.v, tt.decimals, got, tt.want)
+		}
+		// Formatted amounts parse back to the same value.
+		if back, err := ParseUnits(FormatUnits(v, tt.decimals), tt.decimals); err != nil || back.Cmp(v) != 0 {
+			t.Errorf("ParseUnits(FormatUnits(%v, %d)) = %v, %v", tt.v, tt.decimals, back, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// Verification statuses. Full matches include the metadata hash, partial
+// ones only the executable code.
+const (
+	VerificationFull     = "full"
+	VerificationPartial  = "partial"
+	VerificationMismatch = "mismatch"
+)
+
+// Verification is the result of comparing the runtime code at an address
+// with the deployed bytecode stored for a contract.
+type Verification struct {
+	helpers.BaseModel
+	ContractID   string    `json:"contractId"`
+	AccountID    string    `json:"accountId"`
+	DeploymentID string    `json:"deploymentId,omitempty"`
+	Network      string    `json:"network"`
+	Address      string    `json:"address"`
+	Status       string    `json:"status"`
+	Message      string    `json:"message"`
+	VerifiedAt   time.Time `json:"verifiedAt"`
+}
+
+// Verifications returns the verifications of a contract, newest first.
+func Verifications(contractID string, db *gorm.DB) ([]*Verification, error) {
+	var verifications []*Verification
+	err := db.Where("contract_id = ?", contractID).Order("verified_at desc").Find(&verifications).Error
+	return verifications, err
+}
+
+// ParsedImmutableReferences returns the immutable references of c.
+func (c *Contract) ParsedImmutableReferences() (ImmutableReferences, error) {
+	refs := ImmutableReferences{}
+	raw := c.ImmutableReferences.RawMessage
+	if len(raw) == 0 || string(raw) == "null" {
+		return refs, nil
+	}
+	err := json.Unmarshal(raw, &refs)
+	return refs, err
+}
+
+// ExpectedRuntimeCode returns the deployed bytecode of c linked with libs
+// and the ranges whose content is only known once deployed: immutables
+// and libraries without an address in libs.
+func (c *Contract) ExpectedRuntimeCode(libs map[string]common.Address) ([]byte, []LinkReference, error) {
+	code := make([]byte, len(c.DeployedBytecode))
+	copy(code, c.DeployedBytecode)
+
+	refs, err := c.ParsedDeployedLinkReferences()
+	if err != nil {
+		return nil, nil, err
+	}
+	var wildcards []LinkReference
+	for source, names := range refs {
+		for name, positions := range names {
+			address, ok := resolveLibrary(source, name, libs)
+			for _, p := range positions {
+				if p.Start < 0 || p.Start+p.Length > len(code) {
+					return nil, nil, fmt.Errorf("invalid link reference for %v at %d", qualifiedName(source, name), p.Start)
+				}
+				if ok && p.Length == common.AddressLength {
+					copy(code[p.Start:p.Start+p.Length], address.Bytes())
+				} else {
+					wildcards = append(wildcards, p)
+				}
+			}
+		}
+	}
+
+	immutables, err := c.ParsedImmutableReferences()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, positions := range immutables {
+		wildcards = append(wildcards, positions...)
+	}
+	return code, wildcards, nil
+}
+
+// CompareRuntimeCode compares the runtime code on chain with the expected
+// code. Bytes in wildcards are ignored, as is the address libraries push
+// at their start to protect against direct calls. The match is full when
+// the CBOR metadata appended by solc is identical and partial when only
+// the code before it is.
+func CompareRuntimeCode(expected []byte, onchain []byte, wildcards []LinkReference) (string, string) {
+	if len(onchain) == 0 {
+		return VerificationMismatch, "no code at the address"
+	}
+
+	expBody, expMeta := SplitMetadata(expected)
+	body, meta := SplitMetadata(onchain)
+	if len(expBody) != len(body) {
+		return VerificationMismatch, fmt.Sprintf("code is %d bytes on chain, expected %d without metadata", len(body), len(expBody))
+	}
+
+	ignored := make([]bool, len(expBody))
+	for _, w := range wildcards {
+		for i := w.Start; i < w.Start+w.Length && i < len(ignored); i++ {
+			if i >= 0 {
+				ignored[i] = true
+			}
+		}
+	}
+	if isLibraryCode(expBody) {
+		for i := 1; i <= common.AddressLength; i++ {
+			ignored[i] = true
+		}
+	}
+
+	for i := range expBody {
+		if !ignored[i] && expBody[i] != body[i] {
+			return VerificationMismatch, fmt.Sprintf("code differs at byte %d", i)
+		}
+	}
+
+	if bytes.Equal(expMeta, meta) {
+		return VerificationFull, "code and metadata match"
+	}
+	return VerificationPartial, "code matches but the metadata differs"
+}
+
+// isLibraryCode reports whether code starts with PUSH20 of the zero
+// address, which solc replaces with the library address on deployment.
+func isLibraryCode(code []byte) bool {
+	if len(code) <= common.AddressLength || code[0] != 0x73 {
+		return false
+	}
+	for _, b := range code[1 : common.AddressLength+1] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
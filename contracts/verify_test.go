@@ -0,0 +1,76 @@
+package contracts
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// metadata returns CBOR metadata as solc appends it, {"ipfs": hash,
+// "solc": 0.8.7}, followed by its length.
+func metadata(hash byte) []byte {
+	m := []byte{0xa2, 0x64, 'i', 'p', 'f', 's', 0x58, 0x22}
+	m = append(m, bytes.Repeat([]byte{hash}, 34)...)
+	m = append(m, 0x64, 's', 'o', 'l', 'c', 0x43, 0x00, 0x08, 0x07)
+	return append(m, byte(len(m)>>8), byte(len(m)))
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func TestSplitMetadata(t *testing.T) {
+	body := common.FromHex("6080604052600080fd")
+	tests := []struct {
+		name     string
+		code     []byte
+		wantBody []byte
+		wantMeta []byte
+	}{
+		{name: "with metadata", code: concat(body, metadata(1)), wantBody: body, wantMeta: metadata(1)},
+		{name: "without metadata", code: body, wantBody: body},
+		{name: "length beyond code", code: common.FromHex("60ffff"), wantBody: common.FromHex("60ffff")},
+		{name: "not a map", code: common.FromHex("6080600003"), wantBody: common.FromHex("6080600003")},
+		{name: "empty", code: nil},
+	}
+	for _, tt := range tests {
+		body, meta := SplitMetadata(tt.code)
+		if !bytes.Equal(body, tt.wantBody) || !bytes.Equal(meta, tt.wantMeta) {
+			t.Errorf("%v: SplitMetadata = %x, %x, want %x, %x", tt.name, body, meta, tt.wantBody, tt.wantMeta)
+		}
+	}
+}
+
+func TestCompareRuntimeCode(t *testing.T) {
+	body := common.FromHex("608060405273000000000000000000000000000000000000000060005560206000f3")
+	linked := concat(body[:5], common.HexToAddress("0x5a443704dd4B594B382c22a083e2BD3090A6feF3").Bytes(), body[25:])
+	library := concat([]byte{0x73}, make([]byte, 20), common.FromHex("3014608060405260006000fd"))
+	deployedLibrary := concat([]byte{0x73}, common.HexToAddress("0x5a443704dd4B594B382c22a083e2BD3090A6feF3").Bytes(), library[21:])
+	link := []LinkReference{{Start: 5, Length: 20}}
+
+	tests := []struct {
+		name       string
+		expected   []byte
+		onchain    []byte
+		wildcards  []LinkReference
+		wantStatus string
+	}{
+		{name: "full", expected: concat(body, metadata(1)), onchain: concat(body, metadata(1)), wantStatus: VerificationFull},
+		{name: "partial", expected: concat(body, metadata(1)), onchain: concat(body, metadata(2)), wantStatus: VerificationPartial},
+		{name: "no metadata", expected: body, onchain: body, wantStatus: VerificationFull},
+		{name: "no code", expected: body, onchain: nil, wantStatus: VerificationMismatch},
+		{name: "other length", expected: concat(body, metadata(1)), onchain: concat(body[1:], metadata(1)), wantStatus: VerificationMismatch},
+		{name: "other byte", expected: body, onchain: linked, wantStatus: VerificationMismatch},
+		{name: "wildcard", expected: body, onchain: linked, wildcards: link, wantStatus: VerificationFull},
+		{name: "wildcard out of range", expected: body, onchain: body, wildcards: []LinkReference{{Start: -2, Length: 4}, {Start: 30, Length: 20}}, wantStatus: VerificationFull},
+		{name: "library address", expected: concat(library, metadata(1)), onchain: concat(deployedLibrary, metadata(1)), wantStatus: VerificationFull},
+		{name: "library code differs", expected: library, onchain: concat(deployedLibrary[:21], []byte{0x31}, deployedLibrary[22:]), wantStatus: VerificationMismatch},
+	}
+	for _, tt := range tests {
+		status, message := CompareRuntimeCode(tt.expected, tt.onchain, tt.wildcards)
+		if status != tt.wantStatus {
+			t.Errorf("%v: CompareRuntimeCode = %v (%v), want %v", tt.name, status, message, tt.wantStatus)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package contracts
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// Version is a semantic version, see https://semver.org.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease string
+}
+
+// ParseVersion parses a semantic version such as 1.2.0 or 2.0.0-rc.1.
+// Build metadata is ignored.
+func ParseVersion(s string) (Version, error) {
+	m := semverPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid semantic version %q", s)
+	}
+	var v Version
+	var err error
+	if v.Major, err = strconv.ParseUint(m[1], 10, 64); err != nil {
+		return Version{}, err
+	}
+	if v.Minor, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+		return Version{}, err
+	}
+	if v.Patch, err = strconv.ParseUint(m[3], 10, 64); err != nil {
+		return Version{}, err
+	}
+	v.Prerelease = m[4]
+	return v, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Less reports whether v has a lower precedence than o.
+func (v Version) Less(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major < o.Major
+	}
+	if v.Minor != o.Minor {
+		return v.Minor < o.Minor
+	}
+	if v.Patch != o.Patch {
+		return v.Patch < o.Patch
+	}
+	return prereleaseLess(v.Prerelease, o.Prerelease)
+}
+
+// AllowsBreakingChanges reports whether going from v to next may break
+// integrators, which takes a major release or a minor one before 1.0.0.
+func (v Version) AllowsBreakingChanges(next Version) bool {
+	if v.Major == 0 && next.Major == 0 {
+		return next.Minor > v.Minor
+	}
+	return next.Major > v.Major
+}
+
+func prereleaseLess(a string, b string) bool {
+	switch {
+	case a == b:
+		return false
+	case a == "":
+		return false
+	case b == "":
+		return true
+	}
+
+	x, y := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if x[i] == y[i] {
+			continue
+		}
+		nx, errX := strconv.ParseUint(x[i], 10, 64)
+		ny, errY := strconv.ParseUint(y[i], 10, 64)
+		switch {
+		case errX == nil && errY == nil:
+			return nx < ny
+		case errX == nil:
+			return true
+		case errY == nil:
+			return false
+		}
+		return x[i] < y[i]
+	}
+	return len(x) < len(y)
+}
+
+// FamilyVersions returns the versions of the named contract linked to an
+// account, oldest first. Contracts registered without a version are not
+// part of the family.
+func FamilyVersions(accountID string, name string, db *gorm.DB) ([]*Contract, error) {
+	var versions []*Contract
+	if err := db.Joins("JOIN my_contracts ON my_contracts.contract_id = contracts.id::text").
+		Where("my_contracts.account_id = ? AND contracts.name = ? AND contracts.version <> ''", accountID, name).
+		Find(&versions).Error; err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, _ := ParseVersion(versions[i].Version)
+		vj, _ := ParseVersion(versions[j].Version)
+		return vi.Less(vj)
+	})
+	return versions, nil
+}
+
+// CheckNewVersion returns an error response if c can not be published as
+// version v of its family. The version has to be higher than the latest
+// one and breaking ABI changes require a major release unless
+// allowBreaking is set.
+func CheckNewVersion(accountID string, c *Contract, v Version, allowBreaking bool, db *gorm.DB) error {
+	versions, err := FamilyVersions(accountID, c.Name, db)
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+
+	latest := versions[len(versions)-1]
+	lv, err := ParseVersion(latest.Version)
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	if !lv.Less(v) {
+		return helpers.ErrConflict(fmt.Errorf("%v %v must be higher than the latest version %v", c.Name, v, lv))
+	}
+
+	diff, err := DiffABI(string(latest.ABI.RawMessage), string(c.ABI.RawMessage))
+	if err != nil {
+		return helpers.ErrABIInvalid(err)
+	}
+	if diff.Breaking && !allowBreaking && !lv.AllowsBreakingChanges(v) {
+		return helpers.ErrABIBreakingChange(diff)
+	}
+	return nil
+}
+
+// previousVersion returns the highest version of the family of c below
+// its own version, or nil if there is none.
+func previousVersion(accountID string, c *Contract, db *gorm.DB) (*Contract, error) {
+	v, err := ParseVersion(c.Version)
+	if err != nil {
+		return nil, nil
+	}
+	versions, err := FamilyVersions(accountID, c.Name, db)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if pv, err := ParseVersion(versions[i].Version); err == nil && pv.Less(v) {
+			return versions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ResolveContract returns the contract of an account referenced by ID, by
+// family name and version (Token@1.2.0) or by name alone. A name alone
+// resolves to the latest version, or to the most recently registered
+// contract of that name if it has no versions.
+func ResolveContract(accountID string, ref string, db *gorm.DB) (*Contract, error) {
+	if _, err := uuid.FromString(ref); err == nil {
+		m := &MyContract{}
+		if m.FindOrFalse(accountID, ref, db) {
+			return nil, helpers.ErrNotFound("contract", ref)
+		}
+		return &m.Contract, nil
+	}
+
+	name, version := ref, ""
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		name, version = ref[:i], ref[i+1:]
+	}
+	versions, err := FamilyVersions(accountID, name, db)
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+
+	if version != "" {
+		v, err := ParseVersion(version)
+		if err != nil {
+			return nil, helpers.ErrBadRequest(err)
+		}
+		for _, c := range versions {
+			if cv, err := ParseVersion(c.Version); err == nil && cv == v {
+				return c, nil
+			}
+		}
+		return nil, helpers.ErrNotFound("contract", ref)
+	}
+	if len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+
+	c := &Contract{}
+	if db.Joins("JOIN my_contracts ON my_contracts.contract_id = contracts.id::text").
+		Where("my_contracts.account_id = ? AND contracts.name = ?", accountID, name).
+		Order("contracts.created_at desc").First(c).RecordNotFound() {
+		return nil, helpers.ErrNotFound("contract", ref)
+	}
+	return c, nil
+}
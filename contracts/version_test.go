@@ -0,0 +1,75 @@
+package contracts
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Version
+		wantErr bool
+	}{
+		{s: "1.2.3", want: Version{Major: 1, Minor: 2, Patch: 3}},
+		{s: "v0.10.0", want: Version{Minor: 10}},
+		{s: "2.0.0-rc.1", want: Version{Major: 2, Prerelease: "rc.1"}},
+		{s: "1.0.0+build.7", want: Version{Major: 1}},
+		{s: "1.0.0-beta+exp.sha.5114f85", want: Version{Major: 1, Prerelease: "beta"}},
+		{s: "1.2", wantErr: true},
+		{s: "01.2.3", wantErr: true},
+		{s: "1.2.3-", wantErr: true},
+		{s: "latest", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.s)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseVersion(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseVersion(%q) = %+v, want %+v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestVersionLess(t *testing.T) {
+	// Precedence example of the semver specification, lowest first.
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0"}
+	versions := make([]Version, len(ordered))
+	for i := range ordered {
+		v, err := ParseVersion(ordered[len(ordered)-1-i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions[i] = v
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Less(versions[j]) })
+	for i, v := range versions {
+		if v.String() != ordered[i] {
+			t.Errorf("position %d = %v, want %v", i, v, ordered[i])
+		}
+	}
+}
+
+func TestAllowsBreakingChanges(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"1.2.0", "2.0.0", true},
+		{"1.2.0", "1.3.0", false},
+		{"1.2.0", "1.2.1", false},
+		{"0.1.0", "0.2.0", true},
+		{"0.1.0", "0.1.1", false},
+		{"0.9.0", "1.0.0", true},
+	}
+	for _, tt := range tests {
+		from, _ := ParseVersion(tt.from)
+		to, _ := ParseVersion(tt.to)
+		if got := from.AllowsBreakingChanges(to); got != tt.want {
+			t.Errorf("%v -> %v AllowsBreakingChanges = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
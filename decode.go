@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// decodeTransactionHandler fetches a transaction and its receipt from a
+// network and decodes its input and logs. Known contracts of the account
+// are decoded with their ABI, an ABI passed as ?abi= is used for every
+// other address. Inputs of unknown contracts fall back to the signature
+// database.
+func decodeTransactionHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hash := chi.URLParam(r, "hash")
+		if b, err := hexutil.Decode(hash); err != nil || len(b) != common.HashLength {
+			render.Render(w, r, helpers.ErrBadRequest(fmt.Errorf("invalid transaction hash %v", hash)))
+			return
+		}
+
+		network := r.URL.Query().Get("network")
+		if network == "" {
+			network = defaultNetwork
+		}
+
+		var supplied *abi.ABI
+		if s := r.URL.Query().Get("abi"); s != "" {
+			parsed, err := abi.JSON(strings.NewReader(s))
+			if err != nil {
+				render.Render(w, r, helpers.ErrABIInvalid(err))
+				return
+			}
+			supplied = &parsed
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		client, err := networkDialer(network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		defer client.Close()
+
+		decoded, err := decodeTransaction(r.Context(), db, client.Client, a, network, common.HexToHash(hash), supplied)
+		if err == ethereum.NotFound {
+			render.Render(w, r, helpers.ErrNotFound("transaction", hash))
+			return
+		}
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, decoded)
+	})
+}
+
+func decodeTransaction(ctx context.Context, db *gorm.DB, client *ethclient.Client, a *accounts.Account, network string, hash common.Hash, supplied *abi.ABI) (*contracts.DecodedTransaction, error) {
+	tx, pending, err := client.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.NewEIP155Signer(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &contracts.DecodedTransaction{
+		Hash:     hash.Hex(),
+		Network:  network,
+		Status:   contracts.TxPending,
+		From:     from.Hex(),
+		Value:    tx.Value().String(),
+		Nonce:    tx.Nonce(),
+		GasLimit: tx.Gas(),
+		GasPrice: tx.GasPrice().String(),
+		Data:     hexutil.Encode(tx.Data()),
+		Events:   []*contracts.Event{},
+	}
+	abis := &abiCache{db: db, accountID: a.ID.String(), network: network, supplied: supplied, parsed: map[common.Address]*knownABI{}}
+
+	if tx.To() != nil {
+		d.To = tx.To().Hex()
+		known, err := abis.get(*tx.To())
+		if err != nil {
+			return nil, err
+		}
+		d.ContractID = known.contractID
+		d.Input = contracts.DecodeCall(known.abi, tx.Data())
+		if d.Input == nil {
+			if d.Input, err = contracts.DecodeCallWithSignatures(tx.Data(), db); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if pending {
+		return d, nil
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	if err == ethereum.NotFound {
+		// Mined but the receipt is not indexed yet.
+		return d, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.BlockNumber = receipt.BlockNumber.Uint64()
+	d.GasUsed = receipt.GasUsed
+	if tx.To() == nil {
+		d.ContractAddress = receipt.ContractAddress.Hex()
+		known, err := abis.get(receipt.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		d.ContractID = known.contractID
+	}
+
+	for _, l := range receipt.Logs {
+		known, err := abis.get(l.Address)
+		if err != nil {
+			return nil, err
+		}
+		d.Events = append(d.Events, contracts.DecodeLog(known.abi, l))
+	}
+
+	d.Status = contracts.TxSuccess
+	if receipt.Status == types.ReceiptStatusFailed {
+		d.Status = contracts.TxFailed
+		d.Revert = replayForRevert(client, tx, from, receipt, abis)
+	}
+	return d, nil
+}
+
+// replayForRevert replays a failed transaction to recover its revert
+// reason, decoding custom errors with the ABI of the called contract.
+func replayForRevert(client *ethclient.Client, tx *types.Transaction, from common.Address, receipt *types.Receipt, abis *abiCache) *contracts.RevertReason {
+	msg := ethereum.CallMsg{From: from, To: tx.To(), Gas: tx.Gas(), GasPrice: tx.GasPrice(), Value: tx.Value(), Data: tx.Data()}
+
+	var abiJSON string
+	if tx.To() != nil {
+		if known, err := abis.get(*tx.To()); err == nil {
+			abiJSON = known.json
+		}
+	}
+	if reason := replayFailed(client, abiJSON, msg, receipt); reason != nil {
+		return reason
+	}
+	if receipt.GasUsed >= tx.Gas() {
+		return &contracts.RevertReason{Kind: contracts.RevertUnknown, Message: fmt.Sprintf("used all %d gas, out of gas or an invalid opcode", tx.Gas())}
+	}
+	return &contracts.RevertReason{Kind: contracts.RevertUnknown, Message: "transaction failed without revert data"}
+}
+
+// knownABI is the ABI used to decode calls to and logs of an address.
+type knownABI struct {
+	contractID string
+	abi        *abi.ABI
+	json       string
+}
+
+// abiCache looks up the ABI of each address once.
+type abiCache struct {
+	db        *gorm.DB
+	accountID string
+	network   string
+	supplied  *abi.ABI
+	parsed    map[common.Address]*knownABI
+}
+
+func (c *abiCache) get(address common.Address) (*knownABI, error) {
+	if k, ok := c.parsed[address]; ok {
+		return k, nil
+	}
+
+	k := &knownABI{abi: c.supplied}
+	contract, err := contracts.KnownContract(c.accountID, c.network, address, c.db)
+	if err != nil {
+		return nil, err
+	}
+	if contract != nil {
+		raw := contract.ABI.RawMessage
+		if parsed, err := abi.JSON(bytes.NewReader(raw)); err == nil {
+			k = &knownABI{contractID: contract.ID.String(), abi: &parsed, json: string(raw)}
+		}
+	}
+	c.parsed[address] = k
+	return k, nil
+}
@@ -0,0 +1,524 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// txWatchTimeout bounds how long a sent transaction is watched for its receipt.
+const txWatchTimeout = 10 * time.Minute
+
+// defaultConstructorArgs are used when deploying the configured contract
+// without arguments.
+var defaultConstructorArgs = []json.RawMessage{json.RawMessage(`"1.0"`)}
+
+// deployHandler deploys a contract linked to the current account.
+func deployHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.DeployPayload{}
+
+		if r.ContentLength != 0 {
+			if err := render.Bind(r, data); err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		d, created, err := deployContract(db, a, data)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		if created {
+			render.Status(r, http.StatusCreated)
+		}
+		render.Render(w, r, &contracts.DeploymentResponse{Deployment: d})
+	})
+}
+
+// predictAddressHandler returns the CREATE2 address of a contract without
+// sending a transaction.
+func predictAddressHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.DeployPayload{}
+
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+		if data.Salt == "" {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("missing salt")))
+			return
+		}
+		data.ContractID = chi.URLParam(r, "id")
+		if data.Network == "" {
+			data.Network = defaultNetwork
+		}
+
+		conf, err := getConfig()
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		ic, err := buildInitCode(db, conf, a, data)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		salt, _ := contracts.ParseSalt(data.Salt)
+		factory := create2Factory(conf)
+
+		render.Render(w, r, &contracts.PredictAddressResponse{
+			Network:      data.Network,
+			Address:      contracts.Create2Address(factory, salt, ic.code).Hex(),
+			Factory:      factory.Hex(),
+			Salt:         hexutil.Encode(salt[:]),
+			InitCodeHash: crypto.Keccak256Hash(ic.code).Hex(),
+		})
+	})
+}
+
+// initCode is a linked contract with its constructor arguments.
+type initCode struct {
+	contract *contracts.Contract
+	abiJSON  string
+	abi      abi.ABI
+	bytecode []byte
+	args     []interface{}
+	rawArgs  []json.RawMessage
+	libs     map[string]common.Address
+	// code is the linked bytecode followed by the packed constructor arguments.
+	code []byte
+}
+
+// buildInitCode resolves the contract of a deploy request, links its
+// libraries and packs the constructor arguments.
+func buildInitCode(db *gorm.DB, conf *configuration, a *accounts.Account, data *contracts.DeployPayload) (*initCode, error) {
+	c, err := findDeployableContract(db, conf, a, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Bytecode) == 0 {
+		return nil, helpers.ErrBadRequest(errors.New("contract has no creation bytecode"))
+	}
+	ic := &initCode{contract: c, abiJSON: string(c.ABI.RawMessage)}
+
+	if ic.abi, err = abi.JSON(strings.NewReader(ic.abiJSON)); err != nil {
+		return nil, helpers.ErrABIInvalid(err)
+	}
+
+	if ic.libs, err = resolveLibraries(db, a, data.Network, data.Libraries); err != nil {
+		return nil, err
+	}
+	refs, err := c.ParsedLinkReferences()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	if ic.bytecode, err = contracts.Link(c.Bytecode, refs, ic.libs); err != nil {
+		var unresolved *contracts.UnresolvedLibrariesError
+		if errors.As(err, &unresolved) {
+			return nil, helpers.NewErrorResponse(http.StatusUnprocessableEntity, helpers.CodeUnresolvedLibs, err)
+		}
+		return nil, helpers.ErrBadRequest(err)
+	}
+
+	ic.rawArgs = data.Args
+	if ic.rawArgs == nil && data.ContractID == "" {
+		ic.rawArgs = defaultConstructorArgs
+	}
+	if ic.args, err = contracts.ConvertArgs(ic.abi.Constructor.Inputs, ic.rawArgs); err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	packedArgs, err := ic.abi.Pack("", ic.args...)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	ic.code = append(append([]byte{}, ic.bytecode...), packedArgs...)
+
+	return ic, nil
+}
+
+// deployContract deploys the requested contract, through the CREATE2
+// factory when a salt is given. It returns false if the contract already
+// exists at its CREATE2 address and nothing was sent.
+func deployContract(db *gorm.DB, a *accounts.Account, data *contracts.DeployPayload) (*contracts.Deployment, bool, error) {
+	conf, err := getConfig()
+	if err != nil {
+		return nil, false, helpers.ErrInternal(err)
+	}
+
+	if data.Network == "" {
+		data.Network = defaultNetwork
+	}
+	network := data.Network
+
+	ic, err := buildInitCode(db, conf, a, data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s, err := newSender(conf, network)
+	if err != nil {
+		return nil, false, err
+	}
+
+	msg := s.msg(nil, ic.code)
+	address := s.nextContractAddress()
+
+	var salt [32]byte
+	var factory common.Address
+	deterministic := data.Salt != ""
+	if deterministic {
+		salt, _ = contracts.ParseSalt(data.Salt)
+		factory = create2Factory(conf)
+		address = contracts.Create2Address(factory, salt, ic.code)
+
+		code, err := s.client.CodeAt(context.Background(), address, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(code) > 0 {
+			d, err := existingDeployment(db, a, ic.contract, network, address, factory, salt)
+			return d, false, err
+		}
+
+		factoryCode, err := s.client.CodeAt(context.Background(), factory, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(factoryCode) == 0 {
+			return nil, false, helpers.ErrBadRequest(fmt.Errorf("CREATE2 factory %v is not deployed on %v", factory.Hex(), network))
+		}
+
+		msg = s.msg(&factory, contracts.Create2Calldata(salt, ic.code))
+	}
+
+	// Simulate the deployment first so constructor reverts are reported
+	// with their reason instead of wasting gas.
+	reason, err := callForRevert(s, ic.abiJSON, msg)
+	if err != nil {
+		return nil, false, err
+	}
+	if reason != nil {
+		return nil, false, helpers.ErrExecutionReverted(reason)
+	}
+
+	if err := s.estimateGas(&msg); err != nil {
+		return nil, false, err
+	}
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return nil, false, err
+	}
+
+	d, t, err := newDeploymentRecords(a, s, ic, address, tx)
+	if err != nil {
+		return nil, false, err
+	}
+	if deterministic {
+		t.To = factory.Hex()
+		d.Factory = factory.Hex()
+		d.Salt = hexutil.Encode(salt[:])
+	}
+	if err := createRecords(db, t, d); err != nil {
+		return nil, false, err
+	}
+
+	go watchDeployment(db, s.client, d, t, tx, msg, ic.abiJSON)
+
+	return d, true, nil
+}
+
+// newDeploymentRecords returns the unsaved deployment of ic to address and
+// its transaction.
+func newDeploymentRecords(a *accounts.Account, s *sender, ic *initCode, address common.Address, tx *types.Transaction) (*contracts.Deployment, *contracts.Transaction, error) {
+	argsJSON, err := json.Marshal(ic.rawArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	libsJSON, err := json.Marshal(ic.libs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &contracts.Transaction{
+		Hash:       tx.Hash().Hex(),
+		AccountID:  a.ID.String(),
+		ContractID: ic.contract.ID.String(),
+		Network:    s.network,
+		From:       s.from().Hex(),
+		Nonce:      tx.Nonce(),
+		Status:     contracts.TxPending,
+	}
+	d := &contracts.Deployment{
+		ContractID:        ic.contract.ID.String(),
+		Network:           s.network,
+		AccountID:         a.ID.String(),
+		Wallet:            s.from().Hex(),
+		Address:           address.Hex(),
+		TransactionHash:   tx.Hash().Hex(),
+		Nonce:             tx.Nonce(),
+		EffectiveGasPrice: tx.GasPrice().String(),
+		Status:            contracts.TxPending,
+		ConstructorArgs:   postgres.Jsonb{RawMessage: argsJSON},
+		Libraries:         postgres.Jsonb{RawMessage: libsJSON},
+	}
+	return d, t, nil
+}
+
+// createRecords creates all records in a single database transaction.
+func createRecords(db *gorm.DB, records ...interface{}) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, r := range records {
+			if err := tx.Create(r).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// watchDeployment watches the transaction of d and stores the outcome on
+// both. It returns the receipt, or nil if the transaction was not mined.
+func watchDeployment(db *gorm.DB, ethClient *ethclient.Client, d *contracts.Deployment, t *contracts.Transaction, tx *types.Transaction, msg ethereum.CallMsg, abiJSON string) *types.Receipt {
+	receipt := watchTransaction(db, ethClient, t, tx, msg, abiJSON)
+	if receipt == nil {
+		return nil
+	}
+	storeDeploymentReceipt(db, d.TransactionHash, receipt)
+	return receipt
+}
+
+// storeDeploymentReceipt stores the outcome of a deployment transaction on
+// the deployments sent with it.
+func storeDeploymentReceipt(db *gorm.DB, hash string, receipt *types.Receipt) {
+	status := contracts.TxSuccess
+	if receipt.Status == types.ReceiptStatusFailed {
+		status = contracts.TxFailed
+	}
+	if err := db.Model(&contracts.Deployment{}).Where("transaction_hash = ?", hash).Updates(map[string]interface{}{
+		"status":       status,
+		"gas_used":     receipt.GasUsed,
+		"block_number": receipt.BlockNumber.Uint64(),
+	}).Error; err != nil {
+		log.Printf("Watch: deployment %v: %v", hash, err)
+	}
+}
+
+// existingDeployment returns the deployment of a contract which already
+// has code at its CREATE2 address. Code deployed through the factory by
+// someone else is recorded as a new deployment without a transaction.
+func existingDeployment(db *gorm.DB, a *accounts.Account, c *contracts.Contract, network string, address common.Address, factory common.Address, salt [32]byte) (*contracts.Deployment, error) {
+	d := &contracts.Deployment{}
+	if !db.Where("contract_id = ? AND network = ? AND address = ?", c.ID.String(), network, address.Hex()).
+		Order("created_at desc").First(d).RecordNotFound() {
+		return d, nil
+	}
+
+	d = &contracts.Deployment{
+		ContractID: c.ID.String(),
+		Network:    network,
+		AccountID:  a.ID.String(),
+		Address:    address.Hex(),
+		Status:     contracts.TxSuccess,
+		Factory:    factory.Hex(),
+		Salt:       hexutil.Encode(salt[:]),
+	}
+	if err := db.Create(d).Error; err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// create2Factory returns the configured CREATE2 factory, defaulting to the
+// deterministic deployment proxy.
+func create2Factory(conf *configuration) common.Address {
+	if common.IsHexAddress(conf.Create2Factory) {
+		return common.HexToAddress(conf.Create2Factory)
+	}
+	return contracts.DeterministicDeploymentProxy
+}
+
+// findDeployableContract returns the requested contract if it is linked to
+// the account. Without a contract ID the contract from the configuration is
+// registered and linked on first use.
+func findDeployableContract(db *gorm.DB, conf *configuration, a *accounts.Account, data *contracts.DeployPayload) (*contracts.Contract, error) {
+	if data.ContractID != "" {
+		m := &contracts.MyContract{}
+		if m.FindOrFalse(a.ID.String(), data.ContractID, db) {
+			return nil, helpers.ErrNotFound("contract", data.ContractID)
+		}
+		return &m.Contract, nil
+	}
+
+	if _, err := abi.JSON(strings.NewReader(conf.SmartContractABI)); err != nil {
+		return nil, helpers.ErrABIInvalid(err)
+	}
+
+	// Placeholders are not valid hex, common.FromHex would mangle them.
+	bytecode, refs, err := contracts.DecodeBytecode(conf.SmartContractBytecode, nil)
+	if err != nil {
+		return nil, helpers.ErrInternal(fmt.Errorf("smartContractBytecode: %v", err))
+	}
+
+	c := &contracts.Contract{}
+	if db.Where("bytecode = ?", bytecode).First(c).RecordNotFound() {
+		c, err = contracts.NewContractFromArtifact(&contracts.Artifact{
+			ABI:            json.RawMessage(conf.SmartContractABI),
+			Bytecode:       bytecode,
+			LinkReferences: refs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Create(c).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	m := &contracts.MyContract{}
+	if m.FindOrFalse(a.ID.String(), c.ID.String(), db) {
+		m = &contracts.MyContract{AccountID: a.ID.String(), ContractID: c.ID.String()}
+		if err := db.Create(m).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// resolveLibraries returns the library addresses of a deploy request. Values
+// are either addresses or IDs of deployments on the same network which are
+// linked to the account.
+func resolveLibraries(db *gorm.DB, a *accounts.Account, network string, libraries map[string]string) (map[string]common.Address, error) {
+	libs := make(map[string]common.Address, len(libraries))
+	for name, lib := range libraries {
+		if common.IsHexAddress(lib) {
+			libs[name] = common.HexToAddress(lib)
+			continue
+		}
+
+		d := &contracts.Deployment{}
+		m := &contracts.MyContract{}
+		if d.FindByIDOrFalse(lib, db) || m.FindOrFalse(a.ID.String(), d.ContractID, db) {
+			return nil, helpers.ErrNotFound("deployment", lib)
+		}
+		if d.Network != network {
+			return nil, helpers.ErrBadRequest(fmt.Errorf("library %v is deployed on %v, not %v", name, d.Network, network))
+		}
+		if d.Status == contracts.TxFailed {
+			return nil, helpers.ErrBadRequest(fmt.Errorf("library %v deployment failed", name))
+		}
+		libs[name] = common.HexToAddress(d.Address)
+	}
+	return libs, nil
+}
+
+// watchTransaction waits for tx to be mined and stores the outcome on t.
+// It returns the receipt, or nil if the transaction was not mined.
+func watchTransaction(db *gorm.DB, ethClient *ethclient.Client, t *contracts.Transaction, tx *types.Transaction, msg ethereum.CallMsg, abiJSON string) *types.Receipt {
+	ctx, cancel := context.WithTimeout(context.Background(), txWatchTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, ethClient, tx)
+	if err != nil {
+		log.Printf("Watch: transaction %v: %v", t.Hash, err)
+		return nil
+	}
+	storeReceipt(db, ethClient, t, receipt, msg, abiJSON)
+	return receipt
+}
+
+// storeReceipt stores the outcome of t from its receipt. Failed
+// transactions are replayed to recover the revert reason.
+func storeReceipt(db *gorm.DB, ethClient *ethclient.Client, t *contracts.Transaction, receipt *types.Receipt, msg ethereum.CallMsg, abiJSON string) {
+	updates := map[string]interface{}{
+		"status":       contracts.TxSuccess,
+		"block_number": receipt.BlockNumber.Uint64(),
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		updates["status"] = contracts.TxFailed
+
+		reason := replayFailed(ethClient, abiJSON, msg, receipt)
+		if reason == nil {
+			// Out of gas and invalid opcodes do not return revert data.
+			reason = &contracts.RevertReason{Kind: contracts.RevertUnknown, Message: "transaction failed without revert data"}
+		}
+		b, err := json.Marshal(reason)
+		if err != nil {
+			log.Print(err)
+		} else {
+			updates["revert_reason"] = postgres.Jsonb{RawMessage: b}
+		}
+	}
+
+	if err := db.Model(&contracts.Transaction{}).Where("hash = ?", t.Hash).Updates(updates).Error; err != nil {
+		log.Printf("Watch: transaction %v: %v", t.Hash, err)
+	}
+}
+
+// callForRevert simulates msg with eth_call on the latest block and
+// returns its revert reason, decoded with abiJSON, if it reverts. Calls
+// which succeed are not reverted, whatever they return. Other failures,
+// such as insufficient funds or an unreachable node, are returned as
+// errors. The gas limit is estimated afterwards, so the simulation runs
+// with the node's.
+func callForRevert(s *sender, abiJSON string, msg ethereum.CallMsg) (*contracts.RevertReason, error) {
+	msg.Gas, msg.GasPrice = 0, nil
+	_, reason, err := contracts.Call(context.Background(), s.rpc, msg, nil, abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	return reason, nil
+}
+
+// replayFailed replays msg, a transaction which failed according to its
+// receipt, on the state before its block to recover the revert reason.
+// Transactions earlier in the same block are not replayed, so a failure
+// caused by one of them is not reproduced. It returns nil without revert
+// data.
+func replayFailed(ethClient *ethclient.Client, abiJSON string, msg ethereum.CallMsg, receipt *types.Receipt) *contracts.RevertReason {
+	block := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	out, err := ethClient.CallContract(context.Background(), msg, block)
+	if err != nil {
+		reason, _ := contracts.RevertFromError(err, abiJSON)
+		return reason
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return contracts.DecodeRevert(abiJSON, out)
+}
@@ -0,0 +1,578 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+	"github.com/mislavio/contracter/manifests"
+)
+
+// simulatedGasLimit is the block gas limit of the simulated chain.
+const simulatedGasLimit = uint64(12500000)
+
+// simulatedBalance funds the wallet on the simulated chain.
+var simulatedBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// dryRunHandler executes a YAML manifest on a simulated chain and reports
+// the outcome of each step. Nothing is signed or sent to the network.
+func dryRunHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, m, network, err := readManifest(w, r)
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		report, err := dryRunManifest(db, a, network, m)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, report)
+	})
+}
+
+// dryRunManifest runs the steps of m on a simulated chain seeded with the
+// Upvest wallet and its nonce on network, so contracts get the addresses a
+// real run would give them. Accounts and storage of contracts which
+// already exist on the network are copied as the steps read them.
+func dryRunManifest(db *gorm.DB, a *accounts.Account, network string, m *manifests.Manifest) (*manifests.DryRunReport, error) {
+	conf, err := getConfig()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+
+	client, err := dialNetwork(conf, network)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	// Only the address is needed, nothing is signed.
+	opts, err := newUpvestTransactor(newUpvestClient(conf))
+	if err != nil {
+		return nil, err
+	}
+	wallet := opts.From
+
+	nonce, err := client.PendingNonceAt(context.Background(), wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := newSimulatedChain(client, wallet, nonce)
+	if err != nil {
+		return nil, err
+	}
+	defer chain.close()
+
+	report := manifests.NewDryRunReport(m, network, wallet.Hex())
+	outputs := manifests.Outputs{}
+	for i, step := range m.Steps {
+		rs := report.Steps[i]
+		if err := dryRunStep(db, conf, a, network, chain, step, outputs, rs); err != nil {
+			rs.Status = manifests.StatusFailed
+			rs.Error = err.Error()
+		}
+		report.GasUsed += rs.GasUsed
+		if rs.Status != manifests.StatusSuccess {
+			return report, nil
+		}
+		outputs[step.ID] = rs.Outputs
+	}
+
+	report.Success = true
+	return report, nil
+}
+
+// dryRunStep executes a step on chain and records the outcome on rs. Like
+// a real run, plain deployments use the default gas limit while factory
+// deployments and calls are estimated, here by granting the block limit.
+func dryRunStep(db *gorm.DB, conf *configuration, a *accounts.Account, network string, chain *simulatedChain, step *manifests.Step, outputs manifests.Outputs, rs *manifests.DryRunStep) error {
+	args, err := step.ResolveArgs(outputs)
+	if err != nil {
+		return err
+	}
+
+	if step.Kind() == manifests.StepCall {
+		call, err := resolveStepCall(db, a, step, args, outputs)
+		if err != nil {
+			return err
+		}
+		if err := chain.seed(call.to); err != nil {
+			return err
+		}
+		chain.register(call.to, call.contract)
+
+		gas, err := chain.estimateGas(&call.to, call.data)
+		if err != nil {
+			return err
+		}
+		res, err := chain.apply(&call.to, call.data, gas)
+		if err != nil {
+			return err
+		}
+		rs.TransactionHash = res.hash.Hex()
+		rs.Outputs = map[string]string{manifests.OutputTransactionHash: rs.TransactionHash}
+		chain.report(res, call.contract, rs)
+		if rs.Status == manifests.StatusSuccess && len(call.method.Outputs) > 0 {
+			values, err := call.method.Outputs.UnpackValues(res.ret)
+			if err != nil {
+				return fmt.Errorf("%v: decoding return values: %v", call.method.Sig(), err)
+			}
+			rs.Return = contracts.FormatValues(values)
+		}
+		return nil
+	}
+
+	data, err := deployStepPayload(db, a, network, step, args, outputs)
+	if err != nil {
+		return err
+	}
+	ic, err := buildInitCode(db, conf, a, data)
+	if err != nil {
+		return err
+	}
+	for _, lib := range ic.libs {
+		if err := chain.seed(lib); err != nil {
+			return err
+		}
+	}
+
+	to, code := (*common.Address)(nil), ic.code
+	address := crypto.CreateAddress(chain.from, chain.state.GetNonce(chain.from))
+	if data.Salt != "" {
+		salt, _ := contracts.ParseSalt(data.Salt)
+		factory := create2Factory(conf)
+		address = contracts.Create2Address(factory, salt, ic.code)
+
+		if err := chain.seed(address); err != nil {
+			return err
+		}
+		if len(chain.state.GetCode(address)) > 0 {
+			rs.Status = manifests.StatusSuccess
+			rs.Existing = true
+		} else {
+			if err := chain.seed(factory); err != nil {
+				return err
+			}
+			if len(chain.state.GetCode(factory)) == 0 {
+				return fmt.Errorf("CREATE2 factory %v is not deployed on %v", factory.Hex(), network)
+			}
+			to, code = &factory, contracts.Create2Calldata(salt, ic.code)
+		}
+	}
+	chain.register(address, ic.contract)
+
+	rs.Address = address.Hex()
+	rs.Outputs = map[string]string{
+		manifests.OutputAddress:         address.Hex(),
+		manifests.OutputTransactionHash: "",
+		manifests.OutputContractID:      ic.contract.ID.String(),
+		// There are no deployment records, later steps which link this
+		// contract as a library get its address instead.
+		manifests.OutputDeploymentID: address.Hex(),
+	}
+	if rs.Existing {
+		return nil
+	}
+
+	// The gas limit is estimated like the deployment would be.
+	gas, err := chain.estimateGas(to, code)
+	if err != nil {
+		return err
+	}
+	res, err := chain.apply(to, code, gas)
+	if err != nil {
+		return err
+	}
+	rs.TransactionHash = res.hash.Hex()
+	rs.Outputs[manifests.OutputTransactionHash] = rs.TransactionHash
+	chain.report(res, ic.contract, rs)
+	return nil
+}
+
+// forkRounds bounds how often a message is executed again after fetching
+// the network state it read.
+const forkRounds = 64
+
+// simulatedChain executes messages from the wallet on the state of a
+// SimulatedBackend. The backend only accepts signed transactions, which
+// would need the Upvest wallet, so messages are applied to a copy of its
+// state directly with the wallet as sender.
+type simulatedChain struct {
+	backend *backends.SimulatedBackend
+	state   *state.StateDB
+	header  *types.Header
+	from    common.Address
+	txIndex int
+	// network provides the accounts and storage of existing contracts as
+	// of block.
+	network *ethclient.Client
+	block   *big.Int
+	// forked holds the accounts copied from the network and the storage
+	// slots copied for each of them.
+	forked map[common.Address]map[common.Hash]bool
+	// abis of the contracts at each address, to decode events.
+	abis map[common.Address]*abi.ABI
+}
+
+func newSimulatedChain(network *ethclient.Client, from common.Address, nonce uint64) (*simulatedChain, error) {
+	head, err := network.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from: {Balance: simulatedBalance, Nonce: nonce},
+	}, simulatedGasLimit)
+
+	c, err := simulatedChainOn(backend, from)
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	c.network = network
+	c.block = head.Number
+	return c, nil
+}
+
+// simulatedChainOn returns a simulated chain executing messages from from
+// in the block after the head of backend, without a network to fork.
+func simulatedChainOn(backend *backends.SimulatedBackend, from common.Address) (*simulatedChain, error) {
+	st, err := backend.Blockchain().State()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	parent := backend.Blockchain().CurrentBlock()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:   simulatedGasLimit,
+		Time:       uint64(time.Now().Unix()),
+		Difficulty: big.NewInt(1),
+	}
+
+	return &simulatedChain{
+		backend: backend,
+		state:   st,
+		header:  header,
+		from:    from,
+		forked:  map[common.Address]map[common.Hash]bool{},
+		abis:    map[common.Address]*abi.ABI{},
+	}, nil
+}
+
+func (c *simulatedChain) close() {
+	c.backend.Close()
+}
+
+// seed copies the code, balance and nonce of the account at address from
+// the network unless the simulated chain already has an account there.
+// Its storage is copied by fetch as messages read it.
+func (c *simulatedChain) seed(address common.Address) error {
+	if _, ok := c.forked[address]; ok || c.state.Exist(address) {
+		return nil
+	}
+	ctx := context.Background()
+	code, err := c.network.CodeAt(ctx, address, c.block)
+	if err != nil {
+		return err
+	}
+	balance, err := c.network.BalanceAt(ctx, address, c.block)
+	if err != nil {
+		return err
+	}
+	nonce, err := c.network.NonceAt(ctx, address, c.block)
+	if err != nil {
+		return err
+	}
+
+	c.forked[address] = map[common.Hash]bool{}
+	if len(code) > 0 {
+		c.state.SetCode(address, code)
+	}
+	c.state.SetBalance(address, balance)
+	c.state.SetNonce(address, nonce)
+	return nil
+}
+
+// fetch seeds the accounts in reads and copies the storage slots in reads
+// of accounts copied from the network. It reports whether anything was
+// missing.
+func (c *simulatedChain) fetch(reads *stateReads) (bool, error) {
+	missing := false
+	for address := range reads.accounts {
+		if _, ok := c.forked[address]; ok || c.state.Exist(address) {
+			continue
+		}
+		if err := c.seed(address); err != nil {
+			return false, err
+		}
+		missing = true
+	}
+
+	for address, keys := range reads.slots {
+		fetched, ok := c.forked[address]
+		if !ok {
+			// Contracts created on the simulated chain start empty.
+			continue
+		}
+		for key := range keys {
+			if fetched[key] {
+				continue
+			}
+			value, err := c.network.StorageAt(context.Background(), address, key, c.block)
+			if err != nil {
+				return false, err
+			}
+			c.state.SetState(address, key, common.BytesToHash(value))
+			fetched[key] = true
+			missing = true
+		}
+	}
+	return missing, nil
+}
+
+// register records the ABI of the contract at address for decoding events.
+func (c *simulatedChain) register(address common.Address, contract *contracts.Contract) {
+	if parsed, err := abi.JSON(strings.NewReader(string(contract.ABI.RawMessage))); err == nil {
+		c.abis[address] = &parsed
+	}
+}
+
+// simulatedResult is the outcome of a message applied to the chain.
+type simulatedResult struct {
+	hash    common.Hash
+	ret     []byte
+	gas     uint64
+	gasUsed uint64
+	failed  bool
+	logs    []*types.Log
+}
+
+// apply executes a message from the wallet with the next nonce, deploying
+// data when to is nil. The hash identifies the unsigned transaction.
+func (c *simulatedChain) apply(to *common.Address, data []byte, gas uint64) (*simulatedResult, error) {
+	tx, msg := c.message(to, data, gas)
+	if err := c.fork(tx, msg); err != nil {
+		return nil, err
+	}
+
+	ret, gasUsed, failed, err := c.execute(tx, msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.state.Finalise(true)
+	c.txIndex++
+
+	return &simulatedResult{
+		hash:    tx.Hash(),
+		ret:     ret,
+		gas:     gas,
+		gasUsed: gasUsed,
+		failed:  failed,
+		logs:    c.state.GetLogs(tx.Hash()),
+	}, nil
+}
+
+// estimateGas returns the gas limit a message from the wallet is sent
+// with, estimated like a deployment or call of the network: the lowest
+// limit with which it succeeds, searched like eth_estimateGas, plus the
+// margin of withGasMargin. Messages which fail with the block gas limit
+// get the block gas limit, so applying them reports the failure.
+func (c *simulatedChain) estimateGas(to *common.Address, data []byte) (uint64, error) {
+	tx, msg := c.message(to, data, c.header.GasLimit)
+	if err := c.fork(tx, msg); err != nil {
+		return 0, err
+	}
+
+	succeeds := func(gas uint64) (bool, error) {
+		tx, msg := c.message(to, data, gas)
+		snapshot := c.state.Snapshot()
+		defer c.state.RevertToSnapshot(snapshot)
+		_, _, failed, err := c.execute(tx, msg, nil)
+		switch {
+		case err == vm.ErrOutOfGas:
+			// Less gas than the intrinsic gas.
+			return false, nil
+		case err != nil:
+			return false, err
+		}
+		return !failed, nil
+	}
+
+	lo, hi := params.TxGas-1, c.header.GasLimit
+	ok, err := succeeds(hi)
+	if err != nil || !ok {
+		return hi, err
+	}
+	for lo+1 < hi {
+		mid := (lo + hi) / 2
+		ok, err := succeeds(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	if gas := withGasMargin(hi); gas < c.header.GasLimit {
+		return gas, nil
+	}
+	return c.header.GasLimit, nil
+}
+
+// message returns a message from the wallet with the next nonce and the
+// unsigned transaction it stands for.
+func (c *simulatedChain) message(to *common.Address, data []byte, gas uint64) (*types.Transaction, types.Message) {
+	nonce := c.state.GetNonce(c.from)
+	value, gasPrice := big.NewInt(0), big.NewInt(1)
+
+	var tx *types.Transaction
+	if to == nil {
+		tx = types.NewContractCreation(nonce, value, gas, gasPrice, data)
+	} else {
+		tx = types.NewTransaction(nonce, *to, value, gas, gasPrice, data)
+	}
+	return tx, types.NewMessage(c.from, to, nonce, value, gas, gasPrice, data, true)
+}
+
+// fork copies the network state msg reads. A message which reads accounts
+// or storage not yet copied from the network is reverted and executed
+// again once they are, until it reads nothing new. Every slot a committed
+// message touches was copied before, so copies never overwrite simulated
+// writes.
+func (c *simulatedChain) fork(tx *types.Transaction, msg types.Message) error {
+	for round := 1; ; round++ {
+		snapshot := c.state.Snapshot()
+		reads := newStateReads()
+		if _, _, _, err := c.execute(tx, msg, reads); err != nil {
+			return err
+		}
+		c.state.RevertToSnapshot(snapshot)
+
+		missing, err := c.fetch(reads)
+		if err != nil {
+			return err
+		}
+		if !missing {
+			return nil
+		}
+		if round == forkRounds {
+			return fmt.Errorf("transaction still reads new network state after %d executions", forkRounds)
+		}
+	}
+}
+
+// execute applies msg of tx to the state, tracing it when tracer is set.
+func (c *simulatedChain) execute(tx *types.Transaction, msg types.Message, tracer vm.Tracer) ([]byte, uint64, bool, error) {
+	c.state.Prepare(tx.Hash(), common.Hash{}, c.txIndex)
+	chain := c.backend.Blockchain()
+	config := vm.Config{Debug: tracer != nil, Tracer: tracer}
+	evm := vm.NewEVM(core.NewEVMContext(msg, c.header, chain, nil), c.state, chain.Config(), config)
+	return core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(c.header.GasLimit))
+}
+
+// stateReads is a vm.Tracer recording the accounts and storage slots a
+// message accesses. Slots written are recorded too, their original value
+// is part of the gas cost.
+type stateReads struct {
+	accounts map[common.Address]bool
+	slots    map[common.Address]map[common.Hash]bool
+}
+
+func newStateReads() *stateReads {
+	return &stateReads{
+		accounts: map[common.Address]bool{},
+		slots:    map[common.Address]map[common.Hash]bool{},
+	}
+}
+
+func (r *stateReads) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	if !create {
+		r.accounts[to] = true
+	}
+	return nil
+}
+
+func (r *stateReads) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	// Operands are captured before the instruction validates the stack.
+	n := len(stack.Data())
+	switch op {
+	case vm.SLOAD, vm.SSTORE:
+		if n > 0 {
+			address := contract.Address()
+			if r.slots[address] == nil {
+				r.slots[address] = map[common.Hash]bool{}
+			}
+			r.slots[address][common.BigToHash(stack.Back(0))] = true
+		}
+	case vm.BALANCE, vm.EXTCODESIZE, vm.EXTCODECOPY, vm.EXTCODEHASH:
+		if n > 0 {
+			r.accounts[common.BigToAddress(stack.Back(0))] = true
+		}
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		if n > 1 {
+			r.accounts[common.BigToAddress(stack.Back(1))] = true
+		}
+	}
+	return nil
+}
+
+func (r *stateReads) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+func (r *stateReads) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	return nil
+}
+
+// report records gas, events and the revert reason of res on rs.
+func (c *simulatedChain) report(res *simulatedResult, contract *contracts.Contract, rs *manifests.DryRunStep) {
+	rs.GasLimit = res.gas
+	rs.GasUsed = res.gasUsed
+	for _, l := range res.logs {
+		rs.Events = append(rs.Events, contracts.DecodeLog(c.abis[l.Address], l))
+	}
+
+	if !res.failed {
+		rs.Status = manifests.StatusSuccess
+		return
+	}
+
+	rs.Status = manifests.StatusFailed
+	switch {
+	case len(res.ret) > 0:
+		rs.Revert = contracts.DecodeRevert(string(contract.ABI.RawMessage), res.ret)
+	case res.gasUsed >= res.gas:
+		rs.Revert = &contracts.RevertReason{Kind: contracts.RevertUnknown, Message: fmt.Sprintf("used all %d gas, out of gas or an invalid opcode", res.gas)}
+	default:
+		rs.Revert = &contracts.RevertReason{Kind: contracts.RevertUnknown, Message: "transaction failed without revert data"}
+	}
+	rs.Error = rs.Revert.String()
+}
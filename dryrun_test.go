@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mislavio/contracter/testchain"
+)
+
+// testChain returns a simulated chain without a network, where contracts
+// are placed with SetCode.
+func testChain(t *testing.T) *simulatedChain {
+	from := common.HexToAddress("0x00000000000000000000000000000000000000f0")
+	sim := testchain.New(t, simulatedGasLimit, 0, core.GenesisAlloc{from: {Balance: simulatedBalance}})
+	c, err := simulatedChainOn(sim.SimulatedBackend, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestStateReads(t *testing.T) {
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	other := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	lib := common.HexToAddress("0x00000000000000000000000000000000000000b0")
+	push := "73" + hex.EncodeToString(other.Bytes())
+
+	tests := []struct {
+		name     string
+		code     string
+		accounts []common.Address
+		slots    []common.Hash
+	}{
+		{name: "sload", code: "60055400", accounts: []common.Address{contract}, slots: []common.Hash{common.BigToHash(big.NewInt(5))}},
+		{name: "sstore", code: "600160095500", accounts: []common.Address{contract}, slots: []common.Hash{common.BigToHash(big.NewInt(9))}},
+		{name: "balance", code: push + "3100", accounts: []common.Address{contract, other}},
+		{name: "extcodesize", code: push + "3b00", accounts: []common.Address{contract, other}},
+		{name: "call", code: "60006000600060006000" + push + "5af100", accounts: []common.Address{contract, other}},
+		{name: "staticcall", code: "6000600060006000" + push + "5afa00", accounts: []common.Address{contract, other}},
+		// The library reads slot 7 of the calling contract.
+		{name: "delegatecall", code: "600060006000600073" + hex.EncodeToString(lib.Bytes()) + "5af400", accounts: []common.Address{contract, lib}, slots: []common.Hash{common.BigToHash(big.NewInt(7))}},
+		{name: "stack underflow", code: "54", accounts: []common.Address{contract}},
+	}
+	for _, tt := range tests {
+		c := testChain(t)
+		c.state.SetCode(contract, mustDecodeHex(t, tt.code))
+		c.state.SetCode(lib, mustDecodeHex(t, "60075400"))
+
+		msg := types.NewMessage(c.from, &contract, 0, big.NewInt(0), 100000, big.NewInt(1), nil, true)
+		tx := types.NewTransaction(0, contract, big.NewInt(0), 100000, big.NewInt(1), nil)
+		reads := newStateReads()
+		if _, _, _, err := c.execute(tx, msg, reads); err != nil {
+			t.Fatalf("%v: execute() error = %v", tt.name, err)
+		}
+
+		if len(reads.accounts) != len(tt.accounts) {
+			t.Errorf("%v: accounts = %v, want %v", tt.name, reads.accounts, tt.accounts)
+		}
+		for _, a := range tt.accounts {
+			if !reads.accounts[a] {
+				t.Errorf("%v: account %v not recorded", tt.name, a.Hex())
+			}
+		}
+		if len(reads.slots[contract]) != len(tt.slots) || len(reads.slots) > 1 {
+			t.Errorf("%v: slots = %v, want %v of %v", tt.name, reads.slots, tt.slots, contract.Hex())
+		}
+		for _, key := range tt.slots {
+			if !reads.slots[contract][key] {
+				t.Errorf("%v: slot %v not recorded", tt.name, key.Hex())
+			}
+		}
+	}
+}
+
+func TestSimulatedChainApply(t *testing.T) {
+	c := testChain(t)
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	// Increments slot 0.
+	c.state.SetCode(contract, mustDecodeHex(t, "600054600101600055"))
+
+	for i := int64(1); i <= 2; i++ {
+		res, err := c.apply(&contract, nil, defaultGasLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.failed {
+			t.Fatalf("apply() #%d failed", i)
+		}
+		if got := c.state.GetState(contract, common.Hash{}).Big(); got.Cmp(big.NewInt(i)) != 0 {
+			t.Errorf("slot 0 after apply() #%d = %v, want %d", i, got, i)
+		}
+	}
+	if got := c.state.GetNonce(c.from); got != 2 {
+		t.Errorf("nonce = %d, want 2", got)
+	}
+}
+
+// fakeNetwork serves the eth_ methods the simulated chain forks state with.
+type fakeNetwork struct {
+	code         map[common.Address]hexutil.Bytes
+	storage      map[common.Hash]common.Hash
+	storageReads int
+}
+
+func (n *fakeNetwork) GetCode(address common.Address, block string) hexutil.Bytes {
+	return n.code[address]
+}
+
+func (n *fakeNetwork) GetBalance(address common.Address, block string) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1000))
+}
+
+func (n *fakeNetwork) GetTransactionCount(address common.Address, block string) hexutil.Uint64 {
+	return 1
+}
+
+func (n *fakeNetwork) GetStorageAt(address common.Address, key common.Hash, block string) hexutil.Bytes {
+	n.storageReads++
+	value := n.storage[key]
+	return value[:]
+}
+
+func TestSimulatedChainFork(t *testing.T) {
+	existing := common.HexToAddress("0x00000000000000000000000000000000000000e0")
+	network := &fakeNetwork{
+		// Stores the slot named by slot 1 into slot 0.
+		code: map[common.Address]hexutil.Bytes{existing: mustDecodeHex(t, "60015454600055")},
+		storage: map[common.Hash]common.Hash{
+			common.BigToHash(big.NewInt(0)): common.BigToHash(big.NewInt(7)),
+			common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(5)),
+			common.BigToHash(big.NewInt(5)): common.BigToHash(big.NewInt(42)),
+		},
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", network); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	c := testChain(t)
+	c.network = ethclient.NewClient(rpc.DialInProc(server))
+	c.block = big.NewInt(1)
+	if err := c.seed(existing); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.state.GetBalance(existing); got.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("balance = %v, want 1000", got)
+	}
+
+	for i := 1; i <= 2; i++ {
+		res, err := c.apply(&existing, nil, defaultGasLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.failed {
+			t.Fatalf("apply() #%d failed", i)
+		}
+		if got := c.state.GetState(existing, common.Hash{}).Big(); got.Cmp(big.NewInt(42)) != 0 {
+			t.Errorf("slot 0 after apply() #%d = %v, want 42", i, got)
+		}
+	}
+	if network.storageReads != 3 {
+		t.Errorf("storage reads = %d, want 3", network.storageReads)
+	}
+}
+
+func TestSimulatedChainEstimateGas(t *testing.T) {
+	c := testChain(t)
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	reverter := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	// One contract increments slot 0, the other reverts.
+	c.state.SetCode(contract, mustDecodeHex(t, "600054600101600055"))
+	c.state.SetCode(reverter, mustDecodeHex(t, "60006000fd"))
+
+	gas, err := c.estimateGas(&contract, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The lowest limit which succeeds is a sixth of the estimate.
+	lowest := gas * 5 / 6
+	if gas < withGasMargin(lowest) || gas > withGasMargin(lowest+1) {
+		t.Fatalf("estimateGas() = %d, which is not a lowest limit with the margin", gas)
+	}
+	for _, tt := range []struct {
+		gas    uint64
+		failed bool
+	}{{lowest - 5, true}, {lowest + 1, false}} {
+		tx, msg := c.message(&contract, nil, tt.gas)
+		snapshot := c.state.Snapshot()
+		if _, _, failed, err := c.execute(tx, msg, nil); err != nil || failed != tt.failed {
+			t.Errorf("executing with %d gas: failed = %v, %v, want %v", tt.gas, failed, err, tt.failed)
+		}
+		c.state.RevertToSnapshot(snapshot)
+	}
+	// Estimating has no effects.
+	if got := c.state.GetState(contract, common.Hash{}); got != (common.Hash{}) {
+		t.Errorf("slot 0 after estimateGas() = %v, want 0", got.Big())
+	}
+	if got := c.state.GetNonce(c.from); got != 0 {
+		t.Errorf("nonce after estimateGas() = %d, want 0", got)
+	}
+
+	if gas, err := c.estimateGas(&reverter, nil); err != nil || gas != simulatedGasLimit {
+		t.Errorf("estimateGas() of a revert = %d, %v, want the block gas limit", gas, err)
+	}
+
+	// Deploys the incrementing contract.
+	gas, err = c.estimateGas(nil, mustDecodeHex(t, "6009600c60003960096000f3600054600101600055"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.apply(nil, mustDecodeHex(t, "6009600c60003960096000f3600054600101600055"), gas)
+	if err != nil || res.failed {
+		t.Fatalf("apply() with the estimate = %v, %v", res, err)
+	}
+}
@@ -3,6 +3,7 @@ module github.com/mislavio/contracter
 go 1.14
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/ethereum/go-ethereum v1.9.13
 	github.com/go-chi/chi v4.1.1+incompatible
@@ -1,41 +1,238 @@
 package helpers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/render"
+	"github.com/upvestco/upvest-go"
 )
 
-// ErrorResponse is the standard ContracterAPI error format
+// Error codes are stable machine-readable identifiers returned in the
+// code member of every ErrorResponse.
+const (
+	CodeBadRequest         = "bad_request"
+	CodeUnauthorized       = "unauthorized"
+	CodeNotFound           = "not_found"
+	CodeConflict           = "conflict"
+	CodeInternal           = "internal_error"
+	CodeABIInvalid         = "abi_invalid"
+	CodeInvalidSignature   = "invalid_signature"
+	CodeUpvestAuthFailed   = "upvest_auth_failed"
+	CodeUpvestError        = "upvest_error"
+	CodeNodeError          = "node_error"
+	CodeInsufficientFunds  = "insufficient_funds"
+	CodeNonceTooLow        = "nonce_too_low"
+	CodeUnderpriced        = "transaction_underpriced"
+	CodeIntrinsicGasTooLow = "intrinsic_gas_too_low"
+	CodeGasLimitExceeded   = "gas_limit_exceeded"
+	CodeExecutionReverted  = "execution_reverted"
+	CodeUnresolvedLibs     = "unresolved_libraries"
+	CodeLayoutIncompatible = "storage_layout_incompatible"
+	CodeABIBreakingChange  = "abi_breaking_change"
+	CodeNotERC20           = "not_erc20"
+	CodeNotNFT             = "not_nft"
+)
+
+const (
+	problemJSONContentType = "application/problem+json"
+	problemTypeNamespace   = "urn:contracter:error:"
+)
+
+// ErrorResponse is the standard ContracterAPI error format.
+// It follows the RFC 7807 problem details format.
 type ErrorResponse struct {
-	Message string `json:"message"`
-	Status  int    `json:"status"`
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail"`
+	Instance  string      `json:"instance,omitempty"`
+	Code      string      `json:"code"`
+	RequestID string      `json:"requestId,omitempty"`
+	Revert    interface{} `json:"revert,omitempty"`
+	Report    interface{} `json:"report,omitempty"`
 }
 
-// Render sets the error status code.
+// NewErrorResponse returns an error response with the given status and code.
+func NewErrorResponse(status int, code string, err error) *ErrorResponse {
+	return &ErrorResponse{
+		Type:   problemTypeNamespace + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: err.Error(),
+		Code:   code,
+	}
+}
+
+// Error implements the error interface so an ErrorResponse can be
+// returned from helpers and rendered as is by the handler.
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("%v: %v", e.Code, e.Detail)
+}
+
+// Render sets the error status code, request ID and instance.
 func (e *ErrorResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	e.RequestID = middleware.GetReqID(r.Context())
+	e.Instance = r.URL.Path
 	render.Status(r, e.Status)
 	return nil
 }
 
+// Respond is a render.Respond replacement which writes ErrorResponse
+// values as application/problem+json and defers everything else to
+// render.DefaultResponder.
+func Respond(w http.ResponseWriter, r *http.Request, v interface{}) {
+	e, ok := v.(*ErrorResponse)
+	if !ok {
+		render.DefaultResponder(w, r, v)
+		return
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", problemJSONContentType)
+	w.WriteHeader(e.Status)
+	w.Write(b)
+}
+
 // ErrBadRequest returns a 400 status code response.
-func ErrBadRequest(err error) render.Renderer {
-	return &ErrorResponse{Message: err.Error(), Status: 400}
+func ErrBadRequest(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusBadRequest, CodeBadRequest, err)
 }
 
 // ErrUnauthorized returns a 401 status code response.
-func ErrUnauthorized(err error) render.Renderer {
-	return &ErrorResponse{Message: err.Error(), Status: 401}
+func ErrUnauthorized(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusUnauthorized, CodeUnauthorized, err)
 }
 
 // ErrNotFound returns a 404 status code response.
-func ErrNotFound(resource string, key string) render.Renderer {
-	m := fmt.Sprintf("%v (%v) not found", resource, key)
-	return &ErrorResponse{Message: m, Status: 404}
+func ErrNotFound(resource string, key string) *ErrorResponse {
+	m := fmt.Errorf("%v (%v) not found", resource, key)
+	return NewErrorResponse(http.StatusNotFound, CodeNotFound, m)
 }
 
 // ErrConflict returns a 409 status code response.
-func ErrConflict(err error) render.Renderer {
-	return &ErrorResponse{Message: err.Error(), Status: 409}
+func ErrConflict(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusConflict, CodeConflict, err)
+}
+
+// ErrInternal returns a 500 status code response.
+func ErrInternal(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusInternalServerError, CodeInternal, err)
+}
+
+// ErrABIInvalid returns a 400 status code response for unparsable ABIs.
+func ErrABIInvalid(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusBadRequest, CodeABIInvalid, err)
+}
+
+// ErrExecutionReverted returns a 422 status code response carrying the
+// decoded revert reason.
+func ErrExecutionReverted(reason fmt.Stringer) *ErrorResponse {
+	e := NewErrorResponse(http.StatusUnprocessableEntity, CodeExecutionReverted, fmt.Errorf("execution reverted: %v", reason))
+	e.Revert = reason
+	return e
+}
+
+// ErrStorageLayoutIncompatible returns a 409 status code response carrying
+// the storage layout report which blocked an upgrade.
+func ErrStorageLayoutIncompatible(report fmt.Stringer) *ErrorResponse {
+	e := NewErrorResponse(http.StatusConflict, CodeLayoutIncompatible, fmt.Errorf("incompatible storage layout: %v", report))
+	e.Report = report
+	return e
+}
+
+// ErrABIBreakingChange returns a 409 status code response carrying the ABI
+// diff which prevented a new contract version from being published.
+func ErrABIBreakingChange(diff fmt.Stringer) *ErrorResponse {
+	e := NewErrorResponse(http.StatusConflict, CodeABIBreakingChange, fmt.Errorf("breaking ABI changes: %v", diff))
+	e.Report = diff
+	return e
+}
+
+// ErrNotERC20 returns a 422 status code response for token requests to
+// contracts whose ABI does not implement ERC-20.
+func ErrNotERC20(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusUnprocessableEntity, CodeNotERC20, err)
+}
+
+// ErrNotNFT returns a 422 status code response for NFT requests to
+// contracts which implement neither ERC-721 nor ERC-1155.
+func ErrNotNFT(err error) *ErrorResponse {
+	return NewErrorResponse(http.StatusUnprocessableEntity, CodeNotNFT, err)
+}
+
+// nodeErrors maps node rejection messages to error codes. Nodes only
+// return these as JSON-RPC error strings so they are matched by substring.
+var nodeErrors = []struct {
+	match string
+	code  string
+}{
+	{"insufficient funds", CodeInsufficientFunds},
+	{"nonce too low", CodeNonceTooLow},
+	{"replacement transaction underpriced", CodeUnderpriced},
+	{"transaction underpriced", CodeUnderpriced},
+	{"intrinsic gas too low", CodeIntrinsicGasTooLow},
+	{"exceeds block gas limit", CodeGasLimitExceeded},
+	{"gas required exceeds allowance", CodeGasLimitExceeded},
+	{"execution reverted", CodeExecutionReverted},
+}
+
+// ErrUpstream maps errors returned by the Upvest API or the Ethereum node
+// to an error response. Errors which already are an ErrorResponse are
+// returned unchanged.
+func ErrUpstream(err error) *ErrorResponse {
+	var e *ErrorResponse
+	if errors.As(err, &e) {
+		return e
+	}
+
+	if upvestErr, ok := rootCause(err).(*upvest.Error); ok {
+		switch upvestErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return NewErrorResponse(http.StatusBadGateway, CodeUpvestAuthFailed, err)
+		}
+		if strings.Contains(err.Error(), "OAuth2 preflight request failed") {
+			return NewErrorResponse(http.StatusBadGateway, CodeUpvestAuthFailed, err)
+		}
+		return NewErrorResponse(http.StatusBadGateway, CodeUpvestError, err)
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, n := range nodeErrors {
+		if strings.Contains(msg, n.match) {
+			return NewErrorResponse(http.StatusUnprocessableEntity, n.code, err)
+		}
+	}
+
+	return NewErrorResponse(http.StatusBadGateway, CodeNodeError, err)
+}
+
+// rootCause unwraps both standard library and pkg/errors style wrappers.
+func rootCause(err error) error {
+	for err != nil {
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			if e.Unwrap() == nil {
+				return err
+			}
+			err = e.Unwrap()
+		case interface{ Cause() error }:
+			if e.Cause() == nil {
+				return err
+			}
+			err = e.Cause()
+		default:
+			return err
+		}
+	}
+	return err
 }
@@ -1,53 +1,63 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/big"
 	"net/http"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	"github.com/mislavio/contracter/accounts"
 	"github.com/mislavio/contracter/auth"
 	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+	"github.com/mislavio/contracter/manifests"
 	"github.com/rs/cors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
 
 	"github.com/upvestco/upvest-go"
 )
 
 type configuration struct {
-	UpvestPassword        string `yaml:"upvestPassword"`
-	UpvestUsername        string `yaml:"upvestUsername"`
-	UpvestWalletID        string `yaml:"upvestWalletID"`
-	WalletAddress         string `yaml:"walletAddress"`
-	UpvestOAuthID         string `yaml:"upvestOAuthID"`
-	UpvestOAuthSecret     string `yaml:"upvestOAuthSecret"`
-	UpvestBaseURL         string `yaml:"upvestBaseURL"`
-	UpvestEtherAssetID    string `yaml:"upvestEtherAssetID"`
-	SmartContractABI      string `yaml:"smartContractABI"`
-	SmartContractBytecode string `yaml:"smartContractBytecode"`
-	InfuraProjectID       string `yaml:"infuraProjectID"`
+	UpvestPassword        string            `yaml:"upvestPassword"`
+	UpvestUsername        string            `yaml:"upvestUsername"`
+	UpvestWalletID        string            `yaml:"upvestWalletID"`
+	WalletAddress         string            `yaml:"walletAddress"`
+	UpvestOAuthID         string            `yaml:"upvestOAuthID"`
+	UpvestOAuthSecret     string            `yaml:"upvestOAuthSecret"`
+	UpvestBaseURL         string            `yaml:"upvestBaseURL"`
+	UpvestEtherAssetID    string            `yaml:"upvestEtherAssetID"`
+	SmartContractABI      string            `yaml:"smartContractABI"`
+	SmartContractBytecode string            `yaml:"smartContractBytecode"`
+	InfuraProjectID       string            `yaml:"infuraProjectID"`
+	Networks              map[string]string `yaml:"networks"`
+	Create2Factory        string            `yaml:"create2Factory"`
+	CallCache             string            `yaml:"callCache"`
 }
 
 const listenPort int = 8000
 
+// defaultNetwork is used when a request does not name a network.
+const defaultNetwork = "ropsten"
+
 var jwtauth *auth.ContracterJWT
 
+// callCache caches contract reads made through networkDialer.
+var callCache *contracts.CallCache
+
+// maxCachedCalls bounds the reads cached in memory.
+const maxCachedCalls = 100000
+
 func getConfig() (*configuration, error) {
 	var conf configuration
 
@@ -68,62 +78,75 @@ func getConfig() (*configuration, error) {
 	return &conf, nil
 }
 
-func deployContract() (string, string) {
-	conf, err := getConfig()
-	if err != nil {
-		log.Panic(err)
+// dialRPC connects to the named network. Networks are configured as a
+// name to RPC URL mapping and ropsten falls back to Infura.
+func dialRPC(conf *configuration, name string) (*rpc.Client, error) {
+	url, ok := conf.Networks[name]
+	if !ok && name == defaultNetwork {
+		url, ok = "https://ropsten.infura.io/v3/"+conf.InfuraProjectID, true
 	}
-
-	ethClient, err := ethclient.Dial("https://ropsten.infura.io/v3/" + conf.InfuraProjectID)
-	if err != nil {
-		log.Panic(err)
+	if !ok {
+		return nil, helpers.ErrBadRequest(fmt.Errorf("unknown network %v", name))
 	}
 
-	c := upvest.NewClient(conf.UpvestBaseURL, nil)
-	c.SetUA("upvest-go/1.0.0")
-
-	clienteleClient := c.NewClientele(
-		conf.UpvestOAuthID,
-		conf.UpvestOAuthSecret,
-		conf.UpvestUsername,
-		conf.UpvestPassword,
-	)
+	return rpc.Dial(url)
+}
 
-	w, err := clienteleClient.Wallet.Get(conf.UpvestWalletID)
+// dialNetwork connects to the named network like dialRPC.
+func dialNetwork(conf *configuration, name string) (*ethclient.Client, error) {
+	client, err := dialRPC(conf, name)
 	if err != nil {
-		log.Panic(err)
+		return nil, err
 	}
+	return ethclient.NewClient(client), nil
+}
 
-	fromAddress := common.HexToAddress(w.Address)
-
-	nonce, err := ethClient.PendingNonceAt(context.Background(), fromAddress)
+// configuredNetwork connects to a network from the current configuration.
+func configuredNetwork(name string) (*ethclient.Client, error) {
+	conf, err := getConfig()
 	if err != nil {
-		log.Panic(err)
+		return nil, helpers.ErrInternal(err)
 	}
+	return dialNetwork(conf, name)
+}
 
-	gasPrice, err := ethClient.SuggestGasPrice(context.Background())
+// networkDialer connects to a network from the current configuration with
+// contract reads going through the call cache.
+func networkDialer(name string) (*contracts.Client, error) {
+	conf, err := getConfig()
 	if err != nil {
-		log.Panic(err)
+		return nil, helpers.ErrInternal(err)
 	}
-
-	parsedABI, err := abi.JSON(strings.NewReader(conf.SmartContractABI))
+	client, err := dialRPC(conf, name)
 	if err != nil {
-		log.Panic(err)
+		return nil, err
 	}
+	return callCache.Client(client, name), nil
+}
 
-	auth := newUpvestTransactor(clienteleClient)
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)     // in wei
-	auth.GasLimit = uint64(300000) // in units
-	auth.GasPrice = gasPrice
-
-	address, tx, _, err := bind.DeployContract(auth, parsedABI, common.FromHex(conf.SmartContractBytecode), ethClient, "1.0")
+// newCallCache returns the call cache with the backend from the
+// configuration, in memory unless it is postgres.
+func newCallCache(db *gorm.DB) *contracts.CallCache {
+	conf, err := getConfig()
 	if err != nil {
-		log.Panic(err)
+		log.Print(err)
+	}
+	if conf.CallCache == contracts.CallStorePostgres {
+		return contracts.NewCallCache(contracts.NewPostgresCallStore(db), contracts.CallStorePostgres, configuredNetwork)
 	}
+	return contracts.NewCallCache(contracts.NewMemoryCallStore(maxCachedCalls), contracts.CallStoreMemory, configuredNetwork)
+}
 
-	return address.Hex(), tx.Hash().Hex()
+func newUpvestClient(conf *configuration) *upvest.ClienteleAPI {
+	c := upvest.NewClient(conf.UpvestBaseURL, nil)
+	c.SetUA("upvest-go/1.0.0")
 
+	return c.NewClientele(
+		conf.UpvestOAuthID,
+		conf.UpvestOAuthSecret,
+		conf.UpvestUsername,
+		conf.UpvestPassword,
+	)
 }
 
 func writeJSONResponse(w http.ResponseWriter, content []byte) {
@@ -132,6 +155,7 @@ func writeJSONResponse(w http.ResponseWriter, content []byte) {
 }
 
 func init() {
+	render.Respond = helpers.Respond
 	jwtauth = &auth.ContracterJWT{SigningKey: []byte("very_secret_secret"), Signer: jwt.SigningMethodHS256}
 }
 
@@ -152,8 +176,28 @@ func main() {
 		&auth.OAuthCredentials{},
 		&contracts.Contract{},
 		&contracts.MyContract{},
+		&contracts.Transaction{},
+		&contracts.Deployment{},
+		&contracts.Proxy{},
+		&contracts.ProxyImplementation{},
+		&contracts.Verification{},
+		&contracts.Signature{},
+		&contracts.NFTOwner{},
+		&contracts.NFTIndex{},
+		&contracts.CallResult{},
+		&manifests.Pipeline{},
+		&manifests.PipelineStep{},
 	)
 
+	if err := contracts.BackfillSignatures(db); err != nil {
+		log.Print(err)
+	}
+
+	callCache = newCallCache(db)
+
+	go reconcilePending(db)
+	resumeInterruptedPipelines(db)
+
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
@@ -168,11 +212,55 @@ func main() {
 		r.Use(auth.Verifier(jwtauth))
 		r.Use(auth.AccountAuthenticator(db))
 
-		r.Post("/contracts/deploy", func(w http.ResponseWriter, r *http.Request) {
-			address, hash := deployContract()
-			body := fmt.Sprintf("The address of the contract is: \n%v\n\nThe transaction hash is: \n%v\n", address, hash)
-			w.Write([]byte(body))
-		})
+		r.Post("/contracts", contracts.CreateContracts(db))
+		r.Post("/contracts/deploy", deployHandler(db))
+		r.Post("/contracts/import", contracts.ImportContract(db, networkDialer))
+		r.Get("/contracts/families/{name}", contracts.ListVersions(db))
+		r.Get("/contracts/{id}/deployments", contracts.ListDeployments(db))
+		r.Get("/contracts/{id}/abi-diff", contracts.DiffContract(db))
+		r.Post("/contracts/{id}/predict-address", predictAddressHandler(db))
+		r.Post("/contracts/{id}/verify", contracts.VerifyContract(db, networkDialer))
+		r.Get("/contracts/{id}/verifications", contracts.ListVerifications(db))
+		r.Get("/deployments/{id}", contracts.GetDeployment(db))
+		r.Post("/proxies", deployProxyHandler(db))
+		r.Get("/proxies/{id}", contracts.GetProxy(db))
+		r.Post("/proxies/{id}/upgrade", upgradeProxyHandler(db))
+		r.Post("/proxies/{id}/change-admin", changeProxyAdminHandler(db))
+		r.Post("/proxies/{id}/check-upgrade", contracts.CheckUpgrade(db))
+		r.Get("/manifests/{name}", manifests.ListNetworkVersions(db))
+		r.Get("/manifests/{name}/drift", manifests.GetDrift(db))
+		r.Post("/manifests/{name}/promote", promoteHandler(db))
+		r.Post("/pipelines", createPipelineHandler(db))
+		r.Post("/pipelines/dry-run", dryRunHandler(db))
+		r.Get("/pipelines/{id}", manifests.GetPipeline(db))
+		r.Post("/pipelines/{id}/resume", resumePipelineHandler(db))
+		r.Post("/signatures/import", contracts.ImportSignatureDump(db))
+		r.Get("/signatures/{selector}", contracts.LookupSelector(db))
+		r.Get("/topics/{hash}", contracts.LookupTopic(db))
+		r.Get("/tokens/{id}", contracts.GetToken(db, networkDialer))
+		r.Get("/tokens/{id}/balances/{address}", contracts.GetTokenBalance(db, networkDialer))
+		r.Get("/tokens/{id}/allowances/{owner}/{spender}", contracts.GetTokenAllowance(db, networkDialer))
+		r.Post("/tokens/{id}/transfer", tokenTransferHandler(db))
+		r.Post("/tokens/{id}/transfer-from", tokenTransferFromHandler(db))
+		r.Post("/tokens/{id}/approve", tokenApproveHandler(db))
+		r.Get("/nfts/{id}", contracts.GetNFT(db, networkDialer))
+		r.Get("/nfts/{id}/tokens/{tokenId}", contracts.GetNFTToken(db, networkDialer))
+		r.Get("/nfts/{id}/balances/{address}", contracts.GetNFTBalance(db, networkDialer))
+		r.Get("/nfts/{id}/owners", contracts.ListNFTOwners(db))
+		r.Post("/nfts/{id}/index", contracts.IndexNFTOwners(db, networkDialer))
+		r.Post("/nfts/{id}/transfer", nftTransferHandler(db))
+		r.Post("/nfts/{id}/batch-transfer", nftBatchTransferHandler(db))
+		r.Post("/nfts/{id}/approve", nftApproveHandler(db))
+		r.Post("/nfts/{id}/mint", nftMintHandler(db))
+		r.Get("/templates", contracts.ListTemplates())
+		r.Get("/templates/{name}", contracts.GetTemplate())
+		r.Post("/templates/{name}/deploy", templateDeployHandler(db))
+		r.Post("/calls/batch", contracts.BatchCalls(db, networkDialer))
+		r.Get("/calls/cache", contracts.GetCallCacheStats(callCache))
+		r.Get("/contracts/{id}/storage/{slot}", contracts.GetStorage(db, networkDialer))
+		r.Get("/addresses/{addr}", contracts.GetAccountState(networkDialer, defaultNetwork))
+		r.Get("/transactions/{hash}", contracts.GetTransaction(db))
+		r.Get("/transactions/{hash}/decoded", decodeTransactionHandler(db))
 	})
 
 	c := cors.New(cors.Options{
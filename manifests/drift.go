@@ -0,0 +1,311 @@
+package manifests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/contracts"
+)
+
+// Drift kinds
+const (
+	DriftMissing  = "missing"
+	DriftBytecode = "bytecode"
+	DriftArgs     = "args"
+)
+
+// NetworkVersion is the manifest version last completed on a network.
+type NetworkVersion struct {
+	Network     string `json:"network"`
+	Version     string `json:"version"`
+	PipelineID  string `json:"pipelineId"`
+	CompletedAt string `json:"completedAt"`
+}
+
+// NetworkVersions returns the version of the named manifest last completed
+// on each network, taken from the successful pipelines of the account.
+func NetworkVersions(accountID string, name string, db *gorm.DB) ([]*NetworkVersion, error) {
+	var pipelines []*Pipeline
+	if err := db.Where("account_id = ? AND name = ? AND status = ?", accountID, name, StatusSuccess).
+		Order("updated_at desc").Find(&pipelines).Error; err != nil {
+		return nil, err
+	}
+
+	versions := []*NetworkVersion{}
+	seen := map[string]bool{}
+	for _, p := range pipelines {
+		if seen[p.Network] {
+			continue
+		}
+		seen[p.Network] = true
+		versions = append(versions, &NetworkVersion{
+			Network:     p.Network,
+			Version:     p.Version,
+			PipelineID:  p.ID.String(),
+			CompletedAt: p.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		})
+	}
+	return versions, nil
+}
+
+// LatestPipeline returns the last successful pipeline of the named manifest
+// on network, or nil if there is none.
+func LatestPipeline(accountID string, name string, network string, db *gorm.DB) (*Pipeline, error) {
+	p := &Pipeline{}
+	err := db.Where("account_id = ? AND name = ? AND network = ? AND status = ?", accountID, name, network, StatusSuccess).
+		Order("updated_at desc").First(p).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// StepState is the latest successful execution of a manifest step on a
+// network, with the step as defined by the manifest which executed it.
+type StepState struct {
+	Step     *Step
+	Pipeline *Pipeline
+	Record   *PipelineStep
+	Outputs  map[string]string
+}
+
+// NetworkState returns the state of each step of the named manifest on
+// network keyed by step ID. Steps of all pipelines count, including
+// failed ones, as their successful steps were executed on chain.
+func NetworkState(accountID string, name string, network string, db *gorm.DB) (map[string]*StepState, error) {
+	var records []*PipelineStep
+	if err := db.Joins("JOIN pipelines ON pipelines.id::text = pipeline_steps.pipeline_id").
+		Where("pipelines.account_id = ? AND pipelines.name = ? AND pipelines.network = ? AND pipeline_steps.status = ? AND pipeline_steps.reused IS NOT TRUE", accountID, name, network, StatusSuccess).
+		Order("pipeline_steps.updated_at desc").Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	state := map[string]*StepState{}
+	pipelines := map[string]*Pipeline{}
+	parsed := map[string]*Manifest{}
+	for _, r := range records {
+		if _, ok := state[r.StepID]; ok {
+			continue
+		}
+
+		p, ok := pipelines[r.PipelineID]
+		if !ok {
+			p = &Pipeline{}
+			if err := db.Where("id = ?", r.PipelineID).First(p).Error; err != nil {
+				return nil, err
+			}
+			m, err := Parse([]byte(p.Manifest))
+			if err != nil {
+				return nil, fmt.Errorf("pipeline %v: %v", p.ID, err)
+			}
+			pipelines[r.PipelineID], parsed[r.PipelineID] = p, m
+		}
+
+		outputs, err := r.ParsedOutputs()
+		if err != nil {
+			return nil, err
+		}
+		state[r.StepID] = &StepState{Step: parsed[r.PipelineID].step(r.StepID), Pipeline: p, Record: r, Outputs: outputs}
+	}
+	return state, nil
+}
+
+// step returns the step with the given ID, or nil.
+func (m *Manifest) step(id string) *Step {
+	for _, s := range m.Steps {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// StepLocation is where a step was executed on a network.
+type StepLocation struct {
+	Version         string `json:"version"`
+	PipelineID      string `json:"pipelineId"`
+	Address         string `json:"address,omitempty"`
+	ContractID      string `json:"contractId,omitempty"`
+	DeploymentID    string `json:"deploymentId,omitempty"`
+	TransactionHash string `json:"transactionHash,omitempty"`
+}
+
+// StepDrift compares a step of the reference manifest between networks.
+type StepDrift struct {
+	StepID   string        `json:"stepId"`
+	Kind     string        `json:"kind"`
+	InSync   bool          `json:"inSync"`
+	Drift    []string      `json:"drift"`
+	Messages []string      `json:"messages,omitempty"`
+	Source   *StepLocation `json:"source"`
+	Target   *StepLocation `json:"target,omitempty"`
+}
+
+// DriftReport compares the deployment of a manifest on a target network
+// with the manifest version last completed on the source network.
+type DriftReport struct {
+	Name          string       `json:"name"`
+	Source        string       `json:"source"`
+	Target        string       `json:"target"`
+	SourceVersion string       `json:"sourceVersion"`
+	TargetVersion string       `json:"targetVersion,omitempty"`
+	InSync        bool         `json:"inSync"`
+	Steps         []*StepDrift `json:"steps"`
+}
+
+// Drift compares the steps of the manifest of reference, a pipeline on the
+// source network, with their state on target. Steps are missing when they
+// never succeeded on target. Deployed contracts differ in bytecode when the
+// creation bytecode is not the same and steps differ in args when their
+// arguments, libraries, salt or method as written in the manifests differ.
+// Arguments are compared before references are resolved, as the
+// addresses they resolve to differ between networks.
+func Drift(accountID string, reference *Pipeline, target string, db *gorm.DB) (*DriftReport, error) {
+	m, err := Parse([]byte(reference.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	source, err := NetworkState(accountID, reference.Name, reference.Network, db)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := NetworkState(accountID, reference.Name, target, db)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DriftReport{
+		Name:          reference.Name,
+		Source:        reference.Network,
+		Target:        target,
+		SourceVersion: reference.Version,
+		InSync:        true,
+		Steps:         []*StepDrift{},
+	}
+	if latest, err := LatestPipeline(accountID, reference.Name, target, db); err != nil {
+		return nil, err
+	} else if latest != nil {
+		report.TargetVersion = latest.Version
+	}
+
+	for _, step := range m.Steps {
+		d := &StepDrift{StepID: step.ID, Kind: step.Kind(), Drift: []string{}, Source: location(source[step.ID])}
+		if err := compareStep(step, dest[step.ID], d, db); err != nil {
+			return nil, err
+		}
+		d.InSync = len(d.Drift) == 0
+		if !d.InSync {
+			report.InSync = false
+		}
+		report.Steps = append(report.Steps, d)
+	}
+	return report, nil
+}
+
+func compareStep(step *Step, target *StepState, d *StepDrift, db *gorm.DB) error {
+	if target == nil || target.Step == nil {
+		d.Drift = append(d.Drift, DriftMissing)
+		d.Messages = append(d.Messages, fmt.Sprintf("%v has not been executed on the target network", step.ID))
+		return nil
+	}
+	d.Target = location(target)
+	prev := target.Step
+
+	if !sameStepArgs(step, prev) {
+		d.Drift = append(d.Drift, DriftArgs)
+		d.Messages = append(d.Messages, fmt.Sprintf("%v was executed with different arguments in version %v", step.ID, target.Pipeline.Version))
+	}
+
+	if step.Kind() == StepDeploy && d.Source != nil && d.Source.ContractID != d.Target.ContractID {
+		same, err := sameBytecode(d.Source.ContractID, d.Target.ContractID, db)
+		if err != nil {
+			return err
+		}
+		if !same {
+			d.Drift = append(d.Drift, DriftBytecode)
+			d.Messages = append(d.Messages, fmt.Sprintf("%v deployed different bytecode on the target network", step.ID))
+		}
+	}
+	return nil
+}
+
+func sameStepArgs(a *Step, b *Step) bool {
+	if a.Kind() != b.Kind() || a.Method != b.Method || a.Call != b.Call || a.Salt != b.Salt {
+		return false
+	}
+	if len(a.Libraries) > 0 || len(b.Libraries) > 0 {
+		if !reflect.DeepEqual(a.Libraries, b.Libraries) {
+			return false
+		}
+	}
+	x, errX := json.Marshal(a.Args)
+	y, errY := json.Marshal(b.Args)
+	return errX == nil && errY == nil && bytes.Equal(x, y)
+}
+
+func sameBytecode(a string, b string, db *gorm.DB) (bool, error) {
+	var x, y contracts.Contract
+	if err := db.Where("id = ?", a).First(&x).Error; err != nil {
+		return false, err
+	}
+	if err := db.Where("id = ?", b).First(&y).Error; err != nil {
+		return false, err
+	}
+	return bytes.Equal(x.Bytecode, y.Bytecode), nil
+}
+
+func location(s *StepState) *StepLocation {
+	if s == nil {
+		return nil
+	}
+	return &StepLocation{
+		Version:         s.Pipeline.Version,
+		PipelineID:      s.Pipeline.ID.String(),
+		Address:         s.Outputs[OutputAddress],
+		ContractID:      s.Outputs[OutputContractID],
+		DeploymentID:    s.Outputs[OutputDeploymentID],
+		TransactionHash: s.Outputs[OutputTransactionHash],
+	}
+}
+
+// Promote returns an unsaved pipeline running the manifest of reference on
+// target. Steps which already succeeded on target are marked successful
+// with their outputs, so only the missing ones are executed. Steps which
+// call or use an output of a step executed again are executed again too.
+func Promote(accountID string, reference *Pipeline, target string, db *gorm.DB) (*Pipeline, []*PipelineStep, *Manifest, error) {
+	m, err := Parse([]byte(reference.Manifest))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	state, err := NetworkState(accountID, reference.Name, target, db)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	p, steps := NewPipeline(accountID, target, []byte(reference.Manifest), m)
+	p.Source = reference.Network
+
+	rerun := map[string]bool{}
+	for i, step := range m.Steps {
+		s, ok := state[step.ID]
+		if !ok || step.dependsOn(rerun) {
+			rerun[step.ID] = true
+			continue
+		}
+		b, err := json.Marshal(s.Outputs)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		steps[i].Status = StatusSuccess
+		steps[i].Reused = true
+		steps[i].TransactionHash = s.Record.TransactionHash
+		steps[i].Outputs.RawMessage = b
+	}
+	if len(rerun) == 0 {
+		return nil, nil, m, nil
+	}
+	return p, steps, m, nil
+}
@@ -0,0 +1,55 @@
+package manifests
+
+import (
+	"net/http"
+
+	"github.com/mislavio/contracter/contracts"
+)
+
+// StatusSkipped marks dry run steps after a failed step.
+const StatusSkipped = "skipped"
+
+// DryRunReport is the outcome of executing a manifest on a simulated chain.
+type DryRunReport struct {
+	Name    string        `json:"name"`
+	Version string        `json:"version,omitempty"`
+	Network string        `json:"network"`
+	Wallet  string        `json:"wallet"`
+	Success bool          `json:"success"`
+	GasUsed uint64        `json:"gasUsed"`
+	Steps   []*DryRunStep `json:"steps"`
+}
+
+// DryRunStep is the simulated execution of a manifest step. Existing is
+// set for CREATE2 deployments which are already on the network and would
+// not be sent.
+type DryRunStep struct {
+	StepID          string                  `json:"stepId"`
+	Kind            string                  `json:"kind"`
+	Status          string                  `json:"status"`
+	Address         string                  `json:"address,omitempty"`
+	TransactionHash string                  `json:"transactionHash,omitempty"`
+	Existing        bool                    `json:"existing,omitempty"`
+	GasLimit        uint64                  `json:"gasLimit,omitempty"`
+	GasUsed         uint64                  `json:"gasUsed"`
+	Return          []interface{}           `json:"return,omitempty"`
+	Events          []*contracts.Event      `json:"events"`
+	Revert          *contracts.RevertReason `json:"revert,omitempty"`
+	Outputs         map[string]string       `json:"outputs,omitempty"`
+	Error           string                  `json:"error,omitempty"`
+}
+
+// NewDryRunReport returns a report for m with all steps skipped until
+// they are executed.
+func NewDryRunReport(m *Manifest, network string, wallet string) *DryRunReport {
+	r := &DryRunReport{Name: m.Name, Version: m.Version, Network: network, Wallet: wallet}
+	for _, s := range m.Steps {
+		r.Steps = append(r.Steps, &DryRunStep{StepID: s.ID, Kind: s.Kind(), Status: StatusSkipped, Events: []*contracts.Event{}})
+	}
+	return r
+}
+
+// Render implements the renderer interface.
+func (r *DryRunReport) Render(w http.ResponseWriter, req *http.Request) error {
+	return nil
+}
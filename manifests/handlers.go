@@ -0,0 +1,149 @@
+package manifests
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Request Response payloads.
+
+// PipelineResponse represents a pipeline with its steps.
+type PipelineResponse struct {
+	*Pipeline
+	Steps []*PipelineStep `json:"steps"`
+}
+
+// Render implements the renderer interface. The status is left to the
+// handler as started pipelines are returned with 202.
+func (p *PipelineResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// NewPipelineResponse loads the steps of p.
+func NewPipelineResponse(p *Pipeline, db *gorm.DB) (*PipelineResponse, error) {
+	steps, err := p.Steps(db)
+	if err != nil {
+		return nil, err
+	}
+	return &PipelineResponse{Pipeline: p, Steps: steps}, nil
+}
+
+// PromotePayload represents a request to deploy the manifest last
+// completed on the source network to the target network.
+type PromotePayload struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// NetworkVersionResponse represents the manifest version of a network.
+type NetworkVersionResponse struct {
+	*NetworkVersion
+}
+
+// Bind implements the binder interface.
+func (p *PromotePayload) Bind(r *http.Request) error {
+	if p.Source == "" || p.Target == "" {
+		return errors.New("missing source or target network")
+	}
+	if p.Source == p.Target {
+		return errors.New("source and target network are the same")
+	}
+	return nil
+}
+
+// Render implements the renderer interface.
+func (n *NetworkVersionResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Render implements the renderer interface.
+func (d *DriftReport) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// Request Handlers
+
+// ListNetworkVersions returns the version of a manifest last completed on
+// each network.
+func ListNetworkVersions(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		name := chi.URLParam(r, "name")
+
+		versions, err := NetworkVersions(a.ID.String(), name, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if len(versions) == 0 {
+			render.Render(w, r, helpers.ErrNotFound("manifest", name))
+			return
+		}
+
+		list := []render.Renderer{}
+		for _, v := range versions {
+			list = append(list, &NetworkVersionResponse{v})
+		}
+		render.RenderList(w, r, list)
+	})
+}
+
+// GetDrift compares the deployment of a manifest on the target network
+// with the version last completed on the source network.
+func GetDrift(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		name := chi.URLParam(r, "name")
+
+		data := &PromotePayload{Source: r.URL.Query().Get("source"), Target: r.URL.Query().Get("target")}
+		if err := data.Bind(r); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		reference, err := LatestPipeline(a.ID.String(), name, data.Source, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if reference == nil {
+			render.Render(w, r, helpers.ErrNotFound("manifest", name+" on "+data.Source))
+			return
+		}
+
+		report, err := Drift(a.ID.String(), reference, data.Target, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Render(w, r, report)
+	})
+}
+
+// GetPipeline returns a pipeline of the current account with its steps.
+func GetPipeline(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &Pipeline{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("pipeline", id))
+			return
+		}
+
+		resp, err := NewPipelineResponse(p, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Render(w, r, resp)
+	})
+}
@@ -0,0 +1,316 @@
+package manifests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v2"
+)
+
+// Step kinds
+const (
+	StepDeploy = "deploy"
+	StepCall   = "call"
+)
+
+// Step outputs which can be referenced by later steps as ${step.output}.
+const (
+	OutputAddress         = "address"
+	OutputTransactionHash = "transactionHash"
+	OutputDeploymentID    = "deploymentId"
+	OutputContractID      = "contractId"
+)
+
+var (
+	stepIDPattern    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+	referencePattern = regexp.MustCompile(`\$\{([A-Za-z][A-Za-z0-9_-]*)\.([A-Za-z]+)\}`)
+)
+
+var deployOutputs = map[string]bool{OutputAddress: true, OutputTransactionHash: true, OutputDeploymentID: true, OutputContractID: true}
+var callOutputs = map[string]bool{OutputTransactionHash: true}
+
+// Manifest is a declarative multi-step deployment. Steps run in order and
+// may reference the outputs of earlier steps, e.g.
+//
+//	name: token-vault
+//	version: 1.0.0
+//	network: ropsten
+//	steps:
+//	  - id: token
+//	    contract: Token@1.2.0
+//	    args: ["Token", "TKN"]
+//	  - id: vault
+//	    contract: Vault
+//	    args: ["${token.address}"]
+//	  - id: minter
+//	    call: token
+//	    method: setMinter
+//	    args: ["${vault.address}"]
+type Manifest struct {
+	Name    string  `yaml:"name" json:"name"`
+	Version string  `yaml:"version" json:"version"`
+	Network string  `yaml:"network" json:"network"`
+	Steps   []*Step `yaml:"steps" json:"steps"`
+}
+
+// Step deploys a contract or, when Call is set, calls a function of a
+// deployed contract.
+type Step struct {
+	ID string `yaml:"id" json:"id"`
+	// Contract is the contract to deploy or, for calls to an address, the
+	// contract whose ABI is used. It is a contract ID, a family name with a
+	// version (Token@1.2.0) or a name alone for the latest version.
+	Contract  string            `yaml:"contract" json:"contract,omitempty"`
+	Args      []interface{}     `yaml:"args" json:"args,omitempty"`
+	Libraries map[string]string `yaml:"libraries" json:"libraries,omitempty"`
+	Salt      string            `yaml:"salt" json:"salt,omitempty"`
+	// Call is the ID of an earlier deploy step or an address.
+	Call   string `yaml:"call" json:"call,omitempty"`
+	Method string `yaml:"method" json:"method,omitempty"`
+}
+
+// Kind returns StepDeploy or StepCall.
+func (s *Step) Kind() string {
+	if s.Call != "" {
+		return StepCall
+	}
+	return StepDeploy
+}
+
+// Outputs are the outputs of executed steps keyed by step ID.
+type Outputs map[string]map[string]string
+
+// Parse parses and validates a YAML manifest.
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.UnmarshalStrict(data, m); err != nil {
+		return nil, err
+	}
+	for _, s := range m.Steps {
+		for i, arg := range s.Args {
+			s.Args[i] = jsonValue(arg)
+		}
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Manifest) validate() error {
+	if m.Name == "" {
+		return errors.New("missing manifest name")
+	}
+	if len(m.Steps) == 0 {
+		return errors.New("manifest has no steps")
+	}
+
+	kinds := map[string]string{}
+	for i, s := range m.Steps {
+		if s == nil || !stepIDPattern.MatchString(s.ID) {
+			return fmt.Errorf("step %d: invalid or missing id", i)
+		}
+		if _, ok := kinds[s.ID]; ok {
+			return fmt.Errorf("step %v: duplicate id", s.ID)
+		}
+
+		switch s.Kind() {
+		case StepDeploy:
+			if s.Contract == "" {
+				return fmt.Errorf("step %v: missing contract", s.ID)
+			}
+			if s.Method != "" {
+				return fmt.Errorf("step %v: method without call", s.ID)
+			}
+		case StepCall:
+			if s.Method == "" {
+				return fmt.Errorf("step %v: missing method", s.ID)
+			}
+			if s.Salt != "" || len(s.Libraries) > 0 {
+				return fmt.Errorf("step %v: calls take no salt or libraries", s.ID)
+			}
+			if kind, ok := kinds[s.Call]; ok {
+				if kind != StepDeploy {
+					return fmt.Errorf("step %v: %v is not a deploy step", s.ID, s.Call)
+				}
+			} else if !common.IsHexAddress(s.Call) {
+				return fmt.Errorf("step %v: call target %v is neither an earlier step nor an address", s.ID, s.Call)
+			} else if s.Contract == "" {
+				return fmt.Errorf("step %v: calls to an address need a contract for the ABI", s.ID)
+			}
+		}
+
+		for _, ref := range s.references() {
+			kind, ok := kinds[ref[1]]
+			if !ok {
+				return fmt.Errorf("step %v: %v does not reference an earlier step", s.ID, ref[0])
+			}
+			if outputs := outputsOf(kind); !outputs[ref[2]] {
+				return fmt.Errorf("step %v: %v steps have no output %v", s.ID, kind, ref[2])
+			}
+		}
+
+		kinds[s.ID] = s.Kind()
+	}
+	return nil
+}
+
+func outputsOf(kind string) map[string]bool {
+	if kind == StepCall {
+		return callOutputs
+	}
+	return deployOutputs
+}
+
+// references returns all ${step.output} references of s as regexp matches.
+func (s *Step) references() [][]string {
+	var refs [][]string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case string:
+			refs = append(refs, referencePattern.FindAllStringSubmatch(v, -1)...)
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		case map[string]interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(s.Args)
+	for _, lib := range s.Libraries {
+		walk(lib)
+	}
+	return refs
+}
+
+// dependsOn reports whether s calls one of the steps in ids or references
+// an output of one of them in its arguments or libraries.
+func (s *Step) dependsOn(ids map[string]bool) bool {
+	if s.Kind() == StepCall && ids[s.Call] {
+		return true
+	}
+	for _, ref := range s.references() {
+		if ids[ref[1]] {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveArgs returns the arguments of s as JSON with all references
+// replaced by the outputs of earlier steps.
+func (s *Step) ResolveArgs(outputs Outputs) ([]json.RawMessage, error) {
+	args := make([]json.RawMessage, len(s.Args))
+	for i, arg := range s.Args {
+		v, err := resolveValue(arg, outputs)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// ResolveLibraries returns the libraries of s with all references replaced.
+func (s *Step) ResolveLibraries(outputs Outputs) (map[string]string, error) {
+	libs := make(map[string]string, len(s.Libraries))
+	for name, lib := range s.Libraries {
+		v, err := resolveString(lib, outputs)
+		if err != nil {
+			return nil, err
+		}
+		libs[name] = v
+	}
+	return libs, nil
+}
+
+// Target returns the address a call step is sent to and, if the target is
+// an earlier step, the contract deployed by it.
+func (s *Step) Target(outputs Outputs) (common.Address, string, error) {
+	if common.IsHexAddress(s.Call) {
+		return common.HexToAddress(s.Call), "", nil
+	}
+	out, ok := outputs[s.Call]
+	if !ok {
+		return common.Address{}, "", fmt.Errorf("step %v has not run yet", s.Call)
+	}
+	return common.HexToAddress(out[OutputAddress]), out[OutputContractID], nil
+}
+
+func resolveValue(v interface{}, outputs Outputs) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		return resolveString(v, outputs)
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			r, err := resolveValue(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			r, err := resolveValue(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[k] = r
+		}
+		return resolved, nil
+	}
+	return v, nil
+}
+
+func resolveString(s string, outputs Outputs) (string, error) {
+	var missing error
+	resolved := referencePattern.ReplaceAllStringFunc(s, func(ref string) string {
+		m := referencePattern.FindStringSubmatch(ref)
+		v, ok := outputs[m[1]][m[2]]
+		if !ok && missing == nil {
+			missing = fmt.Errorf("%v has no value yet", ref)
+		}
+		return v
+	})
+	return resolved, missing
+}
+
+// jsonValue converts the maps decoded by yaml.v2 into maps which can be
+// encoded as JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = jsonValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonValue(item)
+		}
+		return v
+	}
+	return v
+}
+
+// String returns the manifest name and version.
+func (m *Manifest) String() string {
+	if m.Version == "" {
+		return m.Name
+	}
+	return strings.Join([]string{m.Name, m.Version}, "@")
+}
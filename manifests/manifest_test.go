@@ -0,0 +1,199 @@
+package manifests
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const tokenVault = `
+name: token-vault
+version: 1.0.0
+network: ropsten
+steps:
+  - id: token
+    contract: Token@1.2.0
+    args: ["Token", "TKN", {"cap": 1000, "owners": ["0x1111111111111111111111111111111111111111"]}]
+  - id: vault
+    contract: Vault
+    args: ["${token.address}"]
+    libraries:
+      Math: "0x5a443704dd4B594B382c22a083e2BD3090A6feF3"
+  - id: minter
+    call: token
+    method: setMinter
+    args: ["${vault.address}"]
+  - id: after
+    contract: Audit
+    args: ["${minter.transactionHash}"]
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{name: "valid", yaml: `
+name: valid
+steps:
+  - id: token
+    contract: Token
+  - id: mint
+    call: token
+    method: mint
+    args: ["${token.address}", 1]
+  - id: external
+    call: "0x5a443704dd4B594B382c22a083e2BD3090A6feF3"
+    contract: Token
+    method: pause
+`},
+		{name: "no name", yaml: "steps: [{id: a, contract: A}]", wantErr: "missing manifest name"},
+		{name: "no steps", yaml: "name: x", wantErr: "no steps"},
+		{name: "unknown field", yaml: "name: x\nsteps: [{id: a, contract: A, gas: 1}]", wantErr: "gas"},
+		{name: "missing id", yaml: "name: x\nsteps: [{contract: A}]", wantErr: "step 0: invalid or missing id"},
+		{name: "invalid id", yaml: "name: x\nsteps: [{id: 1a, contract: A}]", wantErr: "step 0: invalid or missing id"},
+		{name: "duplicate id", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: a, contract: B}]", wantErr: "step a: duplicate id"},
+		{name: "deploy without contract", yaml: "name: x\nsteps: [{id: a}]", wantErr: "step a: missing contract"},
+		{name: "method without call", yaml: "name: x\nsteps: [{id: a, contract: A, method: f}]", wantErr: "step a: method without call"},
+		{name: "call without method", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: b, call: a}]", wantErr: "step b: missing method"},
+		{name: "call with salt", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: b, call: a, method: f, salt: s}]", wantErr: "step b: calls take no salt"},
+		{name: "call of a call", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: b, call: a, method: f}, {id: c, call: b, method: f}]", wantErr: "step c: b is not a deploy step"},
+		{name: "call of a later step", yaml: "name: x\nsteps: [{id: b, call: a, method: f}, {id: a, contract: A}]", wantErr: "neither an earlier step nor an address"},
+		{name: "call of an address without contract", yaml: "name: x\nsteps: [{id: b, call: \"0x5a443704dd4B594B382c22a083e2BD3090A6feF3\", method: f}]", wantErr: "need a contract for the ABI"},
+		{name: "reference to a later step", yaml: "name: x\nsteps: [{id: a, contract: A, args: [\"${b.address}\"]}, {id: b, contract: B}]", wantErr: "step a: ${b.address} does not reference an earlier step"},
+		{name: "reference to itself", yaml: "name: x\nsteps: [{id: a, contract: A, args: [\"${a.address}\"]}]", wantErr: "does not reference an earlier step"},
+		{name: "reference in a library", yaml: "name: x\nsteps: [{id: a, contract: A, libraries: {L: \"${b.address}\"}}]", wantErr: "step a: ${b.address} does not reference an earlier step"},
+		{name: "unknown output", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: b, contract: B, args: [\"${a.balance}\"]}]", wantErr: "deploy steps have no output balance"},
+		{name: "address of a call", yaml: "name: x\nsteps: [{id: a, contract: A}, {id: b, call: a, method: f}, {id: c, contract: C, args: [\"${b.address}\"]}]", wantErr: "call steps have no output address"},
+	}
+	for _, tt := range tests {
+		_, err := Parse([]byte(tt.yaml))
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%v: Parse error = %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%v: Parse error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseSteps(t *testing.T) {
+	m, err := Parse([]byte(tokenVault))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.String() != "token-vault@1.0.0" || m.Network != "ropsten" {
+		t.Errorf("manifest = %v on %v", m, m.Network)
+	}
+
+	kinds := []string{StepDeploy, StepDeploy, StepCall, StepDeploy}
+	if len(m.Steps) != len(kinds) {
+		t.Fatalf("%d steps, want %d", len(m.Steps), len(kinds))
+	}
+	for i, s := range m.Steps {
+		if s.Kind() != kinds[i] {
+			t.Errorf("step %v kind = %v, want %v", s.ID, s.Kind(), kinds[i])
+		}
+	}
+
+	// Maps decoded from YAML must be encodable as JSON.
+	if _, err := json.Marshal(m.Steps[0].Args); err != nil {
+		t.Errorf("args of step token: %v", err)
+	}
+}
+
+func TestResolveArgs(t *testing.T) {
+	m, err := Parse([]byte(tokenVault))
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := Outputs{
+		"token":  {OutputAddress: "0x1111111111111111111111111111111111111111", OutputContractID: "c1"},
+		"vault":  {OutputAddress: "0x2222222222222222222222222222222222222222"},
+		"minter": {OutputTransactionHash: "0xabc"},
+	}
+
+	args, err := m.Steps[0].ResolveArgs(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{`"Token"`, `"TKN"`, `{"cap":1000,"owners":["0x1111111111111111111111111111111111111111"]}`}
+	for i, arg := range args {
+		if string(arg) != want[i] {
+			t.Errorf("arg %d = %s, want %s", i, arg, want[i])
+		}
+	}
+
+	args, err = m.Steps[2].ResolveArgs(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{`"0x2222222222222222222222222222222222222222"`}
+	for i, arg := range args {
+		if string(arg) != want[i] {
+			t.Errorf("arg %d = %s, want %s", i, arg, want[i])
+		}
+	}
+
+	libs, err := m.Steps[1].ResolveLibraries(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(libs, map[string]string{"Math": "0x5a443704dd4B594B382c22a083e2BD3090A6feF3"}) {
+		t.Errorf("libraries = %v", libs)
+	}
+
+	address, contractID, err := m.Steps[2].Target(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.Hex() != "0x1111111111111111111111111111111111111111" || contractID != "c1" {
+		t.Errorf("target = %v, %v", address.Hex(), contractID)
+	}
+
+	if _, err := m.Steps[3].ResolveArgs(Outputs{}); err == nil {
+		t.Error("ResolveArgs without outputs succeeded")
+	}
+	if _, _, err := m.Steps[2].Target(Outputs{}); err == nil {
+		t.Error("Target before the step ran succeeded")
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	m, err := Parse([]byte(tokenVault))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linked := &Step{ID: "linked", Contract: "Linked", Libraries: map[string]string{"Math": "${vault.address}"}}
+
+	tests := []struct {
+		step *Step
+		ids  []string
+		want bool
+	}{
+		{step: m.Steps[0], ids: []string{"vault", "minter"}, want: false},
+		{step: m.Steps[1], ids: []string{"token"}, want: true},
+		{step: m.Steps[2], ids: []string{"token"}, want: true},
+		{step: m.Steps[2], ids: []string{"vault"}, want: true},
+		{step: m.Steps[2], ids: []string{"after"}, want: false},
+		{step: m.Steps[3], ids: []string{"minter"}, want: true},
+		{step: m.Steps[3], ids: []string{"token", "vault"}, want: false},
+		{step: linked, ids: []string{"vault"}, want: true},
+		{step: linked, ids: []string{"token"}, want: false},
+		{step: m.Steps[1], ids: nil, want: false},
+	}
+	for _, tt := range tests {
+		ids := map[string]bool{}
+		for _, id := range tt.ids {
+			ids[id] = true
+		}
+		if got := tt.step.dependsOn(ids); got != tt.want {
+			t.Errorf("%v.dependsOn(%v) = %v, want %v", tt.step.ID, tt.ids, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package manifests
+
+import (
+	"encoding/json"
+
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// Pipeline and step statuses
+const (
+	StatusPending = "pending"
+	StatusRunning = "running"
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+)
+
+// Pipeline is an execution of a manifest on a network.
+type Pipeline struct {
+	helpers.BaseModel
+	AccountID string `json:"accountId"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Network   string `json:"network"`
+	// Source is the network a promoted pipeline's manifest was taken from.
+	Source   string `json:"source,omitempty"`
+	Manifest string `json:"manifest"`
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+// FindOrFalse returns false if the pipeline does not exist or belongs to
+// another account.
+func (p *Pipeline) FindOrFalse(id string, accountID string, db *gorm.DB) bool {
+	return db.Where("id = ? AND account_id = ?", id, accountID).Find(p).RecordNotFound()
+}
+
+// Steps returns the steps of p in execution order.
+func (p *Pipeline) Steps(db *gorm.DB) ([]*PipelineStep, error) {
+	var steps []*PipelineStep
+	err := db.Where("pipeline_id = ?", p.ID.String()).Order("step_index").Find(&steps).Error
+	return steps, err
+}
+
+// PipelineStep is the execution state of a manifest step.
+type PipelineStep struct {
+	helpers.BaseModel
+	PipelineID      string         `json:"pipelineId"`
+	StepIndex       int            `json:"stepIndex"`
+	StepID          string         `json:"stepId"`
+	Kind            string         `json:"kind"`
+	Status          string         `json:"status"`
+	TransactionHash string         `json:"transactionHash,omitempty"`
+	Outputs         postgres.Jsonb `json:"outputs"`
+	Error           string         `json:"error,omitempty"`
+	// Reused is set for steps of promoted pipelines which had already
+	// succeeded on the network and were not executed again.
+	Reused bool `json:"reused,omitempty"`
+}
+
+// ParsedOutputs returns the outputs stored on s.
+func (s *PipelineStep) ParsedOutputs() (map[string]string, error) {
+	out := map[string]string{}
+	raw := s.Outputs.RawMessage
+	if len(raw) == 0 || string(raw) == "null" {
+		return out, nil
+	}
+	err := json.Unmarshal(raw, &out)
+	return out, err
+}
+
+// NewPipeline returns an unsaved pipeline for m with a pending step for
+// each manifest step.
+func NewPipeline(accountID string, network string, source []byte, m *Manifest) (*Pipeline, []*PipelineStep) {
+	p := &Pipeline{
+		AccountID: accountID,
+		Name:      m.Name,
+		Version:   m.Version,
+		Network:   network,
+		Manifest:  string(source),
+		Status:    StatusPending,
+	}
+	steps := make([]*PipelineStep, len(m.Steps))
+	for i, s := range m.Steps {
+		steps[i] = &PipelineStep{StepIndex: i, StepID: s.ID, Kind: s.Kind(), Status: StatusPending}
+	}
+	return p, steps
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// nftTransferHandler safely transfers one token, from the wallet or from
+// an owner which approved it.
+func nftTransferHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.NFTTransferPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		n, s, err := loadNFTSender(r.Context(), db, a, chi.URLParam(r, "id"), data.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		id, _ := contracts.ParseTokenID(data.TokenID)
+		amount, _ := contracts.ParseTokenID(data.Amount)
+		extra, _ := contracts.DecodeData(data.Data)
+		calldata, err := n.TransferCalldata(nftFrom(s, data.From), common.HexToAddress(data.To), id, amount, extra)
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		sendNFTCalls(w, r, db, a, s, n, calldata)
+	})
+}
+
+// nftBatchTransferHandler transfers several tokens to one receiver. ERC-1155
+// tokens are sent in one safeBatchTransferFrom, ERC-721 tokens in one
+// transaction each.
+func nftBatchTransferHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.NFTBatchTransferPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		n, s, err := loadNFTSender(r.Context(), db, a, chi.URLParam(r, "id"), data.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		from, to := nftFrom(s, data.From), common.HexToAddress(data.To)
+		extra, _ := contracts.DecodeData(data.Data)
+		ids, amounts := make([]*big.Int, len(data.TokenIDs)), make([]*big.Int, len(data.Amounts))
+		for i := range data.TokenIDs {
+			ids[i], _ = contracts.ParseTokenID(data.TokenIDs[i])
+			amounts[i], _ = contracts.ParseTokenID(data.Amounts[i])
+		}
+
+		var calls [][]byte
+		if n.Standard == contracts.StandardERC1155 {
+			calldata, err := n.BatchTransferCalldata(from, to, ids, amounts, extra)
+			if err != nil {
+				render.Render(w, r, helpers.ErrBadRequest(err))
+				return
+			}
+			calls = append(calls, calldata)
+		} else {
+			for _, id := range ids {
+				calldata, err := n.TransferCalldata(from, to, id, nil, extra)
+				if err != nil {
+					render.Render(w, r, helpers.ErrBadRequest(err))
+					return
+				}
+				calls = append(calls, calldata)
+			}
+		}
+
+		sendNFTCalls(w, r, db, a, s, n, calls...)
+	})
+}
+
+// nftApproveHandler approves an operator for all tokens of the wallet or
+// a spender for one ERC-721 token.
+func nftApproveHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.NFTApprovePayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		n, s, err := loadNFTSender(r.Context(), db, a, chi.URLParam(r, "id"), data.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		var calldata []byte
+		if data.Operator != "" {
+			calldata, err = n.ABI.Pack("setApprovalForAll", common.HexToAddress(data.Operator), *data.Approved)
+		} else if n.Standard != contracts.StandardERC721 {
+			err = errors.New("ERC-1155 has no per token approvals, approve an operator instead")
+		} else {
+			id, _ := contracts.ParseTokenID(data.TokenID)
+			calldata, err = n.ABI.Pack("approve", common.HexToAddress(data.Spender), id)
+		}
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		sendNFTCalls(w, r, db, a, s, n, calldata)
+	})
+}
+
+// nftMintHandler calls the mint method of a collection from the wallet.
+func nftMintHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.NFTMintPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		n, s, err := loadNFTSender(r.Context(), db, a, chi.URLParam(r, "id"), data.Network)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		calldata, err := n.MintCalldata(data.Method, data.Args)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		sendNFTCalls(w, r, db, a, s, n, calldata)
+	})
+}
+
+// loadNFTSender loads an NFT collection and a sender on its network.
+func loadNFTSender(ctx context.Context, db *gorm.DB, a *accounts.Account, id string, network string) (*contracts.NFT, *sender, error) {
+	conf, err := getConfig()
+	if err != nil {
+		return nil, nil, helpers.ErrInternal(err)
+	}
+
+	var s *sender
+	n, _, err := contracts.LoadNFT(ctx, a.ID.String(), id, network, func(network string) (*contracts.Client, error) {
+		var err error
+		if s, err = newSender(conf, network); err != nil {
+			return nil, err
+		}
+		return callCache.Client(s.rpc, network), nil
+	}, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	return n, s, nil
+}
+
+// sendNFTCalls sends calls to the collection in order and renders the
+// transactions. Calls after a failed one are not sent, the ones before it
+// are recorded and watched like any other transaction.
+func sendNFTCalls(w http.ResponseWriter, r *http.Request, db *gorm.DB, a *accounts.Account, s *sender, n *contracts.NFT, calls ...[]byte) {
+	res := &contracts.NFTTransactionsResponse{}
+	for _, calldata := range calls {
+		tx, err := sendContractCall(db, a, s, n.Contract, n.Address, calldata)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		res.Transactions = append(res.Transactions, tx)
+	}
+	render.Render(w, r, res)
+}
+
+// nftFrom returns the address tokens are transferred from, the wallet
+// unless from is set.
+func nftFrom(s *sender, from string) common.Address {
+	if from == "" {
+		return s.from()
+	}
+	return common.HexToAddress(from)
+}
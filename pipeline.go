@@ -0,0 +1,528 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+	"github.com/mislavio/contracter/manifests"
+	uuid "github.com/satori/go.uuid"
+)
+
+// maxManifestSize bounds the size of uploaded manifests.
+const maxManifestSize = 1 << 20
+
+// txPollInterval is how often a pipeline checks whether the transaction of
+// a running step was mined.
+const txPollInterval = 3 * time.Second
+
+// createPipelineHandler parses a YAML manifest from the request body and
+// runs it as a pipeline in the background. The network query parameter
+// overrides the network of the manifest.
+func createPipelineHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		source, m, network, err := readManifest(w, r)
+		if err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		p, steps := manifests.NewPipeline(a.ID.String(), network, source, m)
+		if err := createPipeline(db, p, steps); err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		go runPipeline(db, a, p, m)
+
+		renderPipeline(w, r, db, p)
+	})
+}
+
+// promoteHandler deploys the manifest last completed on the source network
+// to the target network. Steps which already succeeded on the target are
+// not repeated.
+func promoteHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		name := chi.URLParam(r, "name")
+
+		data := &manifests.PromotePayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		reference, err := manifests.LatestPipeline(a.ID.String(), name, data.Source, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if reference == nil {
+			render.Render(w, r, helpers.ErrNotFound("manifest", name+" on "+data.Source))
+			return
+		}
+
+		p, steps, m, err := manifests.Promote(a.ID.String(), reference, data.Target, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		if p == nil {
+			render.Render(w, r, helpers.ErrConflict(fmt.Errorf("%v has no missing steps on %v", name, data.Target)))
+			return
+		}
+		if err := createPipeline(db, p, steps); err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		go runPipeline(db, a, p, m)
+
+		renderPipeline(w, r, db, p)
+	})
+}
+
+// createPipeline creates a pipeline and its steps in a single transaction.
+func createPipeline(db *gorm.DB, p *manifests.Pipeline, steps []*manifests.PipelineStep) error {
+	return db.Transaction(func(dbTx *gorm.DB) error {
+		if err := dbTx.Create(p).Error; err != nil {
+			return err
+		}
+		for _, s := range steps {
+			s.PipelineID = p.ID.String()
+			if err := dbTx.Create(s).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// resumePipelineHandler reruns a failed pipeline from its failed step.
+// Steps which already succeeded are not repeated, nor is a transaction of
+// the failed step which was mined after all.
+func resumePipelineHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &manifests.Pipeline{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("pipeline", id))
+			return
+		}
+
+		m, err := manifests.Parse([]byte(p.Manifest))
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		// Claim the pipeline so concurrent requests do not resume it twice.
+		res := db.Model(&manifests.Pipeline{}).Where("id = ? AND status = ?", p.ID, manifests.StatusFailed).
+			Updates(map[string]interface{}{"status": manifests.StatusPending, "error": ""})
+		if res.Error != nil {
+			render.Render(w, r, helpers.ErrInternal(res.Error))
+			return
+		}
+		if res.RowsAffected == 0 {
+			render.Render(w, r, helpers.ErrConflict(fmt.Errorf("pipeline is %v, only failed pipelines can be resumed", p.Status)))
+			return
+		}
+		p.Status, p.Error = manifests.StatusPending, ""
+
+		go runPipeline(db, a, p, m)
+
+		renderPipeline(w, r, db, p)
+	})
+}
+
+// resumeInterruptedPipelines restarts the pipelines which were pending or
+// running when the service stopped, as their runs did not survive it.
+// Steps whose transaction was mined in the meantime are not sent again.
+func resumeInterruptedPipelines(db *gorm.DB) {
+	var pipelines []*manifests.Pipeline
+	if err := db.Where("status IN (?)", []string{manifests.StatusPending, manifests.StatusRunning}).Find(&pipelines).Error; err != nil {
+		log.Printf("Pipelines: %v", err)
+		return
+	}
+	for _, p := range pipelines {
+		m, err := manifests.Parse([]byte(p.Manifest))
+		if err != nil {
+			setPipelineStatus(db, p, manifests.StatusFailed, err.Error())
+			continue
+		}
+		a := &accounts.Account{}
+		if err := db.Where("id = ?", p.AccountID).First(a).Error; err != nil {
+			log.Printf("Pipeline %v: %v", p.ID, err)
+			continue
+		}
+		go runPipeline(db, a, p, m)
+	}
+}
+
+// readManifest parses the YAML manifest in the request body and returns
+// it with its source and the network to run it on.
+func readManifest(w http.ResponseWriter, r *http.Request) ([]byte, *manifests.Manifest, string, error) {
+	source, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxManifestSize))
+	if err != nil {
+		return nil, nil, "", err
+	}
+	m, err := manifests.Parse(source)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("invalid manifest: %v", err)
+	}
+
+	network := r.URL.Query().Get("network")
+	if network == "" {
+		network = m.Network
+	}
+	if network == "" {
+		network = defaultNetwork
+	}
+	return source, m, network, nil
+}
+
+func renderPipeline(w http.ResponseWriter, r *http.Request, db *gorm.DB, p *manifests.Pipeline) {
+	resp, err := manifests.NewPipelineResponse(p, db)
+	if err != nil {
+		render.Render(w, r, helpers.ErrInternal(err))
+		return
+	}
+	render.Status(r, http.StatusAccepted)
+	render.Render(w, r, resp)
+}
+
+// runPipeline executes the steps of a pipeline in order, waiting for each
+// transaction to be mined before starting the next step. It stops at the
+// first failing step, which is where a resumed run starts.
+func runPipeline(db *gorm.DB, a *accounts.Account, p *manifests.Pipeline, m *manifests.Manifest) {
+	setPipelineStatus(db, p, manifests.StatusRunning, "")
+
+	steps, err := p.Steps(db)
+	if err != nil {
+		setPipelineStatus(db, p, manifests.StatusFailed, err.Error())
+		return
+	}
+	if len(steps) != len(m.Steps) {
+		setPipelineStatus(db, p, manifests.StatusFailed, "pipeline steps do not match its manifest")
+		return
+	}
+
+	outputs := manifests.Outputs{}
+	for i, ps := range steps {
+		if ps.Status == manifests.StatusSuccess {
+			if outputs[ps.StepID], err = ps.ParsedOutputs(); err != nil {
+				setPipelineStatus(db, p, manifests.StatusFailed, err.Error())
+				return
+			}
+			continue
+		}
+
+		updateStep(db, ps, map[string]interface{}{"status": manifests.StatusRunning, "error": ""})
+
+		out, err := recordedStep(db, p.Network, m.Steps[i], ps)
+		if err == nil && out == nil {
+			updateStep(db, ps, map[string]interface{}{"transaction_hash": ""})
+			out, err = runStep(db, a, p, m.Steps[i], outputs, func(hash string) {
+				updateStep(db, ps, map[string]interface{}{"transaction_hash": hash})
+			})
+		}
+		if err != nil {
+			msg := fmt.Sprintf("step %v: %v", ps.StepID, err)
+			updateStep(db, ps, map[string]interface{}{"status": manifests.StatusFailed, "error": err.Error()})
+			setPipelineStatus(db, p, manifests.StatusFailed, msg)
+			return
+		}
+
+		b, err := json.Marshal(out)
+		if err != nil {
+			log.Printf("Pipeline %v: %v", p.ID, err)
+		}
+		updateStep(db, ps, map[string]interface{}{"status": manifests.StatusSuccess, "outputs": postgres.Jsonb{RawMessage: b}})
+		outputs[ps.StepID] = out
+	}
+
+	setPipelineStatus(db, p, manifests.StatusSuccess, "")
+}
+
+// recordedStep returns the outputs of a step whose recorded transaction was
+// mined successfully, waiting for it while it is pending. It returns nil
+// outputs when the step has to be sent again: it has no transaction, or
+// its transaction failed or is unknown to the network.
+func recordedStep(db *gorm.DB, network string, step *manifests.Step, ps *manifests.PipelineStep) (map[string]string, error) {
+	if ps.TransactionHash == "" {
+		return nil, nil
+	}
+
+	client, err := configuredNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	hash := common.HexToHash(ps.TransactionHash)
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	switch {
+	case err == ethereum.NotFound:
+		if _, _, err := client.TransactionByHash(ctx, hash); err == ethereum.NotFound {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if err := awaitTransaction(db, ps.TransactionHash); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case receipt.Status == types.ReceiptStatusFailed:
+		return nil, nil
+	}
+
+	if step.Kind() == manifests.StepCall {
+		return map[string]string{manifests.OutputTransactionHash: ps.TransactionHash}, nil
+	}
+	d := &contracts.Deployment{}
+	if err := db.Where("transaction_hash = ?", ps.TransactionHash).First(d).Error; err != nil {
+		return nil, err
+	}
+	return deploymentOutputs(d), nil
+}
+
+// runStep executes a manifest step and returns its outputs once its
+// transaction succeeded. sent is called with the transaction hash.
+func runStep(db *gorm.DB, a *accounts.Account, p *manifests.Pipeline, step *manifests.Step, outputs manifests.Outputs, sent func(hash string)) (map[string]string, error) {
+	args, err := step.ResolveArgs(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if step.Kind() == manifests.StepCall {
+		t, err := callStep(db, a, p.Network, step, args, outputs)
+		if err != nil {
+			return nil, err
+		}
+		sent(t.Hash)
+		if err := awaitTransaction(db, t.Hash); err != nil {
+			return nil, err
+		}
+		return map[string]string{manifests.OutputTransactionHash: t.Hash}, nil
+	}
+
+	data, err := deployStepPayload(db, a, p.Network, step, args, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	d, _, err := deployContract(db, a, data)
+	if err != nil {
+		return nil, err
+	}
+	// Contracts which already exist at their CREATE2 address were not sent.
+	if d.TransactionHash != "" {
+		sent(d.TransactionHash)
+		if err := awaitTransaction(db, d.TransactionHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return deploymentOutputs(d), nil
+}
+
+// deploymentOutputs returns the outputs of a deploy step.
+func deploymentOutputs(d *contracts.Deployment) map[string]string {
+	return map[string]string{
+		manifests.OutputAddress:         d.Address,
+		manifests.OutputTransactionHash: d.TransactionHash,
+		manifests.OutputDeploymentID:    d.ID.String(),
+		manifests.OutputContractID:      d.ContractID,
+	}
+}
+
+// deployStepPayload returns the deploy request of a manifest step.
+func deployStepPayload(db *gorm.DB, a *accounts.Account, network string, step *manifests.Step, args []json.RawMessage, outputs manifests.Outputs) (*contracts.DeployPayload, error) {
+	c, err := contracts.ResolveContract(a.ID.String(), step.Contract, db)
+	if err != nil {
+		return nil, err
+	}
+	libs, err := step.ResolveLibraries(outputs)
+	if err != nil {
+		return nil, err
+	}
+	data := &contracts.DeployPayload{
+		ContractID: c.ID.String(),
+		Network:    network,
+		Args:       args,
+		Libraries:  libs,
+		Salt:       step.Salt,
+	}
+	if err := data.Bind(nil); err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	return data, nil
+}
+
+// stepCall is the resolved function call of a manifest step.
+type stepCall struct {
+	to       common.Address
+	contract *contracts.Contract
+	method   abi.Method
+	data     []byte
+}
+
+// resolveStepCall resolves the target, contract and calldata of a call step.
+func resolveStepCall(db *gorm.DB, a *accounts.Account, step *manifests.Step, args []json.RawMessage, outputs manifests.Outputs) (*stepCall, error) {
+	to, contractID, err := step.Target(outputs)
+	if err != nil {
+		return nil, err
+	}
+	ref := contractID
+	if step.Contract != "" {
+		ref = step.Contract
+	}
+	c, err := contracts.ResolveContract(a.ID.String(), ref, db)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(c.ABI.RawMessage)))
+	if err != nil {
+		return nil, helpers.ErrABIInvalid(err)
+	}
+	method, err := contracts.FindMethod(parsed, step.Method)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	data, err := contracts.PackCall(parsed, step.Method, args)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	return &stepCall{to: to, contract: c, method: method, data: data}, nil
+}
+
+// callStep sends the function call of a manifest step.
+func callStep(db *gorm.DB, a *accounts.Account, network string, step *manifests.Step, args []json.RawMessage, outputs manifests.Outputs) (*contracts.Transaction, error) {
+	call, err := resolveStepCall(db, a, step, args, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := getConfig()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	s, err := newSender(conf, network)
+	if err != nil {
+		return nil, err
+	}
+	return sendContractCall(db, a, s, call.contract, call.to, call.data)
+}
+
+// awaitTransaction polls the transaction recorded under hash until its
+// watcher stored the outcome.
+func awaitTransaction(db *gorm.DB, hash string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), txWatchTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		t := &contracts.Transaction{}
+		if err := db.Where("hash = ?", hash).First(t).Error; err != nil {
+			return err
+		}
+		switch t.Status {
+		case contracts.TxSuccess:
+			return nil
+		case contracts.TxFailed:
+			reason := &contracts.RevertReason{}
+			if err := json.Unmarshal(t.RevertReason.RawMessage, reason); err == nil && reason.Message != "" {
+				return fmt.Errorf("transaction %v reverted: %v", hash, reason.Message)
+			}
+			return fmt.Errorf("transaction %v failed", hash)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.New("timed out waiting for transaction " + hash)
+		case <-ticker.C:
+		}
+	}
+}
+
+func updateStep(db *gorm.DB, s *manifests.PipelineStep, updates map[string]interface{}) {
+	if err := db.Model(&manifests.PipelineStep{}).Where("id = ?", s.ID).Updates(updates).Error; err != nil {
+		log.Printf("Pipeline step %v: %v", s.ID, err)
+	}
+}
+
+func setPipelineStatus(db *gorm.DB, p *manifests.Pipeline, status string, msg string) {
+	p.Status, p.Error = status, msg
+	if err := db.Model(&manifests.Pipeline{}).Where("id = ?", p.ID).
+		Updates(map[string]interface{}{"status": status, "error": msg}).Error; err != nil {
+		log.Printf("Pipeline %v: %v", p.ID, err)
+	}
+}
+
+// sendContractCall simulates and sends a call of a contract at to and
+// records the transaction, which is watched in the background.
+func sendContractCall(db *gorm.DB, a *accounts.Account, s *sender, c *contracts.Contract, to common.Address, data []byte) (*contracts.Transaction, error) {
+	abiJSON := string(c.ABI.RawMessage)
+	msg := s.msg(&to, data)
+
+	reason, err := callForRevert(s, abiJSON, msg)
+	if err != nil {
+		return nil, err
+	}
+	if reason != nil {
+		return nil, helpers.ErrExecutionReverted(reason)
+	}
+	if err := s.estimateGas(&msg); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &contracts.Transaction{
+		Hash:       tx.Hash().Hex(),
+		AccountID:  a.ID.String(),
+		ContractID: c.ID.String(),
+		Network:    s.network,
+		From:       s.from().Hex(),
+		To:         to.Hex(),
+		Nonce:      tx.Nonce(),
+		Status:     contracts.TxPending,
+	}
+	if err := db.Create(t).Error; err != nil {
+		return nil, err
+	}
+
+	go watchTransaction(db, s.client, t, tx, msg, abiJSON)
+
+	return t, nil
+}
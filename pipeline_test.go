@@ -0,0 +1,475 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jinzhu/gorm"
+	uuid "github.com/satori/go.uuid"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+	"github.com/mislavio/contracter/manifests"
+	"github.com/mislavio/contracter/testchain"
+	"github.com/mislavio/contracter/testdb"
+)
+
+// Accounts of pipeline chains: the wallet signs through the fake Upvest.
+const (
+	pipelineWallet = 0
+	pipelineOther  = 1
+)
+
+var (
+	tokenArtifact = mustTemplate("erc20").Artifact()
+	tokenABI, _   = abi.JSON(strings.NewReader(string(tokenArtifact.ABI)))
+)
+
+func mustTemplate(name string) *contracts.Template {
+	t, ok := contracts.FindTemplate(name)
+	if !ok {
+		panic("no template " + name)
+	}
+	return t
+}
+
+// upvestServer serves the Upvest wallet walletID, signing with key.
+func upvestServer(t *testing.T, walletID string, key *ecdsa.PrivateKey) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/1.0/clientele/oauth2/token":
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "token", "token_type": "Bearer"})
+		case "/1.0/kms/wallets/" + walletID:
+			json.NewEncoder(w).Encode(map[string]string{"id": walletID, "address": crypto.PubkeyToAddress(key.PublicKey).Hex()})
+		case "/1.0/kms/wallets/" + walletID + "/sign":
+			var params struct {
+				ToSign string `json:"to_sign"`
+			}
+			json.NewDecoder(r.Body).Decode(&params)
+			hash, err := base64.StdEncoding.DecodeString(params.ToSign)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			sig, err := crypto.Sign(hash, key)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{
+				"r":       base64.StdEncoding.EncodeToString(sig[:32]),
+				"s":       base64.StdEncoding.EncodeToString(sig[32:64]),
+				"recover": strconv.Itoa(int(sig[64])),
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// useConfig runs the test in a directory whose config.yaml is conf.
+func useConfig(t *testing.T, conf *configuration) {
+	dir, err := ioutil.TempDir("", "contracter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	})
+
+	b, err := yaml.Marshal(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.yaml"), b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pipelineChain returns a simulated chain configured as the network
+// simulated, with its wallet in a fake Upvest, and a mocked database.
+func pipelineChain(t *testing.T) (*testchain.Chain, *gorm.DB, sqlmock.Sqlmock) {
+	c := testchain.New(t, 8000000, 2, nil)
+	upvest := upvestServer(t, "wallet", c.Keys[pipelineWallet])
+	useConfig(t, &configuration{
+		UpvestBaseURL:  upvest.URL,
+		UpvestWalletID: "wallet",
+		Networks:       map[string]string{"simulated": c.URL()},
+	})
+	db, mock := testdb.New(t)
+	return c, db, mock
+}
+
+// deployToken deploys the ERC-20 template owned by the wallet.
+func deployToken(t *testing.T, c *testchain.Chain) common.Address {
+	args, err := tokenABI.Pack("", "Token", "TKN", uint8(18), big.NewInt(0), c.Address(pipelineWallet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, receipt := c.Deploy(pipelineWallet, 3000000, append(append([]byte{}, tokenArtifact.Bytecode...), args...))
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("deploying the token failed")
+	}
+	return address
+}
+
+// mint sends a mint of the token from account from.
+func mint(t *testing.T, c *testchain.Chain, from int, token common.Address, to common.Address, amount int64) *types.Receipt {
+	data, err := tokenABI.Pack("mint", to, big.NewInt(amount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.Send(from, 200000, token, nil, data)
+}
+
+// balanceOf returns the token balance of owner.
+func balanceOf(t *testing.T, c *testchain.Chain, token common.Address, owner common.Address) int64 {
+	data, err := tokenABI.Pack("balanceOf", owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := c.CallContract(context.Background(), ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return new(big.Int).SetBytes(out).Int64()
+}
+
+func TestRecordedStep(t *testing.T) {
+	c, db, mock := pipelineChain(t)
+	token := deployToken(t, c)
+	minted := mint(t, c, pipelineWallet, token, c.Address(pipelineOther), 5).TxHash.Hex()
+	failed := mint(t, c, pipelineOther, token, c.Address(pipelineOther), 5).TxHash.Hex()
+	deployment := uuid.NewV4()
+	contractID := uuid.NewV4().String()
+
+	deployStep := &manifests.Step{ID: "token", Contract: contractID}
+	callStep := &manifests.Step{ID: "mint", Call: "token", Method: "mint"}
+	tests := []struct {
+		name       string
+		step       *manifests.Step
+		hash       string
+		deployment bool
+		want       map[string]string
+	}{
+		{name: "not sent", step: callStep},
+		{name: "unknown", step: callStep, hash: common.HexToHash("0x01").Hex()},
+		{name: "failed", step: callStep, hash: failed},
+		{name: "mined call", step: callStep, hash: minted, want: map[string]string{manifests.OutputTransactionHash: minted}},
+		{
+			name:       "mined deployment",
+			step:       deployStep,
+			hash:       minted,
+			deployment: true,
+			want: map[string]string{
+				manifests.OutputAddress:         token.Hex(),
+				manifests.OutputTransactionHash: minted,
+				manifests.OutputDeploymentID:    deployment.String(),
+				manifests.OutputContractID:      contractID,
+			},
+		},
+	}
+	for _, tt := range tests {
+		if tt.deployment {
+			mock.ExpectQuery(`SELECT \* FROM "deployments" WHERE .*transaction_hash = \$1`).
+				WithArgs(tt.hash).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "contract_id", "address", "transaction_hash"}).
+					AddRow(deployment.String(), contractID, token.Hex(), tt.hash))
+		}
+		got, err := recordedStep(db, "simulated", tt.step, &manifests.PipelineStep{StepID: tt.step.ID, TransactionHash: tt.hash})
+		if err != nil {
+			t.Errorf("%v: recordedStep() error = %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v: recordedStep() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// pipelineAccount is the account running the pipelines of tests.
+var pipelineAccount = &accounts.Account{BaseModel: helpers.BaseModel{ID: uuid.FromStringOrNil("6b1f0d2e-8a41-4c7e-a3d5-2f9e4b7c1a08")}}
+
+// expectContract expects the lookup of the token contract id linked to
+// pipelineAccount.
+func expectContract(mock sqlmock.Sqlmock, id string) {
+	mock.ExpectQuery(`SELECT \* FROM "my_contracts" WHERE .*account_id = \$1 AND contract_id = \$2`).
+		WithArgs(pipelineAccount.ID.String(), id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "contract_id"}).
+			AddRow(uuid.NewV4().String(), pipelineAccount.ID.String(), id))
+	mock.ExpectQuery(`SELECT \* FROM "contracts" WHERE .*"id" IN \(\$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "abi", "bytecode"}).
+			AddRow(id, []byte(tokenArtifact.ABI), tokenArtifact.Bytecode))
+}
+
+// expectUpdate expects an update of table setting values.
+func expectUpdate(mock sqlmock.Sqlmock, table string, values map[string]interface{}) {
+	var columns []string
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	var set []string
+	var args []driver.Value
+	for i, column := range append(columns, "updated_at") {
+		set = append(set, fmt.Sprintf(`"%v" = \$%d`, column, i+1))
+		if v, ok := values[column]; ok {
+			args = append(args, v)
+		} else {
+			args = append(args, sqlmock.AnyArg())
+		}
+	}
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "` + table + `" SET ` + strings.Join(set, ", ") + ` WHERE`).
+		WithArgs(append(args, sqlmock.AnyArg())...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+// expectAwait expects the transaction sent by a step to be watched until
+// it is stored with status.
+func expectAwait(mock sqlmock.Sqlmock, status string) {
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "transactions" SET .* WHERE .*hash = \$`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT \* FROM "transactions" WHERE .*hash = \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(uuid.NewV4().String(), status))
+}
+
+// expectDeployStep expects a deploy step of the token contract id.
+func expectDeployStep(mock sqlmock.Sqlmock, id string) {
+	expectContract(mock, id)
+	expectContract(mock, id)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "transactions" .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewV4().String()))
+	mock.ExpectQuery(`INSERT INTO "deployments" .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewV4().String()))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "deployments" SET .* WHERE .*transaction_hash = \$`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectAwait(mock, contracts.TxSuccess)
+}
+
+// expectCallStep expects a call step of the token contract id to be sent.
+func expectCallStep(mock sqlmock.Sqlmock, id string) {
+	expectContract(mock, id)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "transactions" .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewV4().String()))
+	mock.ExpectCommit()
+	expectAwait(mock, contracts.TxSuccess)
+}
+
+func TestRunStep(t *testing.T) {
+	c, db, mock := pipelineChain(t)
+	mock.MatchExpectationsInOrder(false)
+	p := &manifests.Pipeline{BaseModel: helpers.BaseModel{ID: uuid.NewV4()}, Network: "simulated"}
+	contractID := uuid.NewV4().String()
+	wallet := c.Address(pipelineWallet)
+
+	expectDeployStep(mock, contractID)
+	var sent []string
+	deploy := &manifests.Step{ID: "token", Contract: contractID, Args: []interface{}{"Token", "TKN", 18, "0", wallet.Hex()}}
+	out, err := runStep(db, pipelineAccount, p, deploy, manifests.Outputs{}, func(hash string) { sent = append(sent, hash) })
+	if err != nil {
+		t.Fatalf("runStep(deploy) error = %v", err)
+	}
+	token := common.HexToAddress(out[manifests.OutputAddress])
+	if code, _ := c.CodeAt(context.Background(), token, nil); len(code) == 0 {
+		t.Fatalf("runStep(deploy) = %v, want the address of the deployed token", out)
+	}
+	if out[manifests.OutputContractID] != contractID || !reflect.DeepEqual(sent, []string{out[manifests.OutputTransactionHash]}) {
+		t.Errorf("runStep(deploy) = %v and sent %v, want contract %v and its transaction sent", out, sent, contractID)
+	}
+	outputs := manifests.Outputs{"token": out}
+
+	// The recipient and the called contract are outputs of the deploy step.
+	expectCallStep(mock, contractID)
+	sent = nil
+	call := &manifests.Step{ID: "mint", Call: "token", Method: "mint", Args: []interface{}{"${token.address}", "7"}}
+	out, err = runStep(db, pipelineAccount, p, call, outputs, func(hash string) { sent = append(sent, hash) })
+	if err != nil {
+		t.Fatalf("runStep(call) error = %v", err)
+	}
+	if !reflect.DeepEqual(sent, []string{out[manifests.OutputTransactionHash]}) {
+		t.Errorf("runStep(call) = %v and sent %v, want its transaction sent", out, sent)
+	}
+	if got := balanceOf(t, c, token, token); got != 7 {
+		t.Errorf("runStep(call) minted %v, want 7", got)
+	}
+
+	// A reverting call is not sent.
+	expectContract(mock, contractID)
+	sent = nil
+	transfer := &manifests.Step{ID: "transfer", Call: "token", Method: "transfer", Args: []interface{}{"${token.address}", "1"}}
+	_, err = runStep(db, pipelineAccount, p, transfer, outputs, func(hash string) { sent = append(sent, hash) })
+	if err == nil || !strings.Contains(err.Error(), "ERC20: transfer amount exceeds balance") || sent != nil {
+		t.Errorf("runStep(transfer) error = %v and sent %v, want a revert before sending", err, sent)
+	}
+}
+
+// expectPipelineSteps expects the steps of p to be read, of which those
+// with outputs succeeded.
+func expectPipelineSteps(mock sqlmock.Sqlmock, p *manifests.Pipeline, m *manifests.Manifest, outputs manifests.Outputs, hashes map[string]string) {
+	rows := sqlmock.NewRows([]string{"id", "pipeline_id", "step_index", "step_id", "kind", "status", "transaction_hash", "outputs"})
+	for i, step := range m.Steps {
+		status, b := manifests.StatusPending, []byte("{}")
+		if out, ok := outputs[step.ID]; ok {
+			status, b = manifests.StatusSuccess, mustJSON(out)
+		}
+		rows.AddRow(uuid.NewV4().String(), p.ID.String(), i, step.ID, step.Kind(), status, hashes[step.ID], b)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "pipeline_steps" WHERE .*pipeline_id = \$1.* ORDER BY step_index`).
+		WithArgs(p.ID.String()).
+		WillReturnRows(rows)
+}
+
+// expectStepRun expects a step to be marked running and, unless its
+// recorded transaction is reused, sent again.
+func expectStepRun(mock sqlmock.Sqlmock, resend bool) {
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusRunning, "error": ""})
+	if resend {
+		expectUpdate(mock, "pipeline_steps", map[string]interface{}{"transaction_hash": ""})
+		expectUpdate(mock, "pipeline_steps", map[string]interface{}{"transaction_hash": sqlmock.AnyArg()})
+	}
+}
+
+func mustJSON(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestRunPipeline(t *testing.T) {
+	c, db, mock := pipelineChain(t)
+	mock.MatchExpectationsInOrder(false)
+	p := &manifests.Pipeline{BaseModel: helpers.BaseModel{ID: uuid.NewV4()}, Network: "simulated"}
+	contractID := uuid.NewV4().String()
+	wallet := c.Address(pipelineWallet)
+	tokenArgs := []interface{}{"Token", "TKN", 18, "0", wallet.Hex()}
+	m := &manifests.Manifest{Steps: []*manifests.Step{
+		{ID: "token", Contract: contractID, Args: tokenArgs},
+		{ID: "second", Contract: contractID, Args: tokenArgs},
+		{ID: "mint", Call: "token", Method: "mint", Args: []interface{}{"${second.address}", "7"}},
+		// The wallet holds none of the second token.
+		{ID: "transfer", Call: "second", Method: "transfer", Args: []interface{}{"${token.address}", "1"}},
+		{ID: "after", Call: "token", Method: "mint", Args: []interface{}{wallet.Hex(), "1"}},
+	}}
+	wantErr := "step transfer: execution_reverted: execution reverted: Error: ERC20: transfer amount exceeds balance"
+
+	expectUpdate(mock, "pipelines", map[string]interface{}{"status": manifests.StatusRunning, "error": ""})
+	expectPipelineSteps(mock, p, m, nil, nil)
+	for _, step := range m.Steps[:3] {
+		expectStepRun(mock, true)
+		if step.Kind() == manifests.StepDeploy {
+			expectDeployStep(mock, contractID)
+		} else {
+			expectCallStep(mock, contractID)
+		}
+		expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusSuccess, "outputs": sqlmock.AnyArg()})
+	}
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusRunning, "error": ""})
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"transaction_hash": ""})
+	expectContract(mock, contractID)
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusFailed, "error": strings.TrimPrefix(wantErr, "step transfer: ")})
+	expectUpdate(mock, "pipelines", map[string]interface{}{"status": manifests.StatusFailed, "error": wantErr})
+
+	runPipeline(db, pipelineAccount, p, m)
+
+	if p.Status != manifests.StatusFailed || p.Error != wantErr {
+		t.Errorf("runPipeline() = %v %q, want %v %q", p.Status, p.Error, manifests.StatusFailed, wantErr)
+	}
+	// Both tokens and the mint were sent, the steps after the failing one
+	// were not.
+	nonce, err := c.NonceAt(context.Background(), wallet, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := crypto.CreateAddress(wallet, 0)
+	second := crypto.CreateAddress(wallet, 1)
+	if nonce != 3 || balanceOf(t, c, token, second) != 7 || balanceOf(t, c, token, wallet) != 0 {
+		t.Errorf("runPipeline() sent %v transactions, minted %v to second and %v to the wallet, want 3, 7 and 0",
+			nonce, balanceOf(t, c, token, second), balanceOf(t, c, token, wallet))
+	}
+}
+
+func TestRunPipelineResume(t *testing.T) {
+	c, db, mock := pipelineChain(t)
+	mock.MatchExpectationsInOrder(false)
+	p := &manifests.Pipeline{BaseModel: helpers.BaseModel{ID: uuid.NewV4()}, Network: "simulated"}
+	contractID := uuid.NewV4().String()
+	wallet, other := c.Address(pipelineWallet), c.Address(pipelineOther)
+
+	// The pipeline stopped while the mint was pending, which was then mined.
+	token := deployToken(t, c)
+	minted := mint(t, c, pipelineWallet, token, other, 5).TxHash.Hex()
+	m := &manifests.Manifest{Steps: []*manifests.Step{
+		{ID: "token", Contract: contractID},
+		{ID: "mint", Call: "token", Method: "mint", Args: []interface{}{other.Hex(), "5"}},
+		{ID: "again", Call: "token", Method: "mint", Args: []interface{}{other.Hex(), "3"}},
+	}}
+	outputs := manifests.Outputs{"token": {
+		manifests.OutputAddress:    token.Hex(),
+		manifests.OutputContractID: contractID,
+	}}
+
+	expectUpdate(mock, "pipelines", map[string]interface{}{"status": manifests.StatusRunning, "error": ""})
+	expectPipelineSteps(mock, p, m, outputs, map[string]string{"mint": minted})
+	expectStepRun(mock, false)
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusSuccess, "outputs": mustJSON(map[string]string{manifests.OutputTransactionHash: minted})})
+	expectStepRun(mock, true)
+	expectCallStep(mock, contractID)
+	expectUpdate(mock, "pipeline_steps", map[string]interface{}{"status": manifests.StatusSuccess, "outputs": sqlmock.AnyArg()})
+	expectUpdate(mock, "pipelines", map[string]interface{}{"status": manifests.StatusSuccess, "error": ""})
+
+	runPipeline(db, pipelineAccount, p, m)
+
+	if p.Status != manifests.StatusSuccess {
+		t.Errorf("runPipeline() = %v %q, want %v", p.Status, p.Error, manifests.StatusSuccess)
+	}
+	nonce, err := c.NonceAt(context.Background(), wallet, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := balanceOf(t, c, token, other); nonce != 3 || got != 8 {
+		t.Errorf("runPipeline() sent %v transactions in all and minted %v, want 3 and 8", nonce, got)
+	}
+}
@@ -0,0 +1,615 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+	uuid "github.com/satori/go.uuid"
+)
+
+// proxyGasLimit is the gas limit of proxy deployments and upgrades. They
+// can not be estimated as they are sent before the implementation is
+// mined, so it covers the proxy itself and a moderate initializer.
+const proxyGasLimit = uint64(1000000)
+
+// deployProxyHandler deploys a contract behind an upgradeable proxy.
+func deployProxyHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.ProxyPayload{}
+
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		p, err := deployProxy(db, a, data)
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		resp, err := contracts.NewProxyResponse(p, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Status(r, http.StatusCreated)
+		render.Render(w, r, resp)
+	})
+}
+
+// upgradeProxyHandler deploys a new implementation and points a proxy of
+// the current account at it. The proxy is switched once the upgrade
+// transaction is mined.
+func upgradeProxyHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &contracts.Proxy{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("proxy", id))
+			return
+		}
+
+		data := &contracts.UpgradePayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		if err := upgradeProxy(db, a, p, data); err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		resp, err := contracts.NewProxyResponse(p, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Status(r, http.StatusAccepted)
+		render.Render(w, r, resp)
+	})
+}
+
+// changeProxyAdminHandler hands a transparent proxy of the current
+// account over to another admin through its ProxyAdmin. The proxy is
+// updated once the transaction is mined.
+func changeProxyAdminHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := auth.AccountFromContext(r.Context())
+		id := chi.URLParam(r, "id")
+
+		p := &contracts.Proxy{}
+		if _, err := uuid.FromString(id); err != nil || p.FindOrFalse(id, a.ID.String(), db) {
+			render.Render(w, r, helpers.ErrNotFound("proxy", id))
+			return
+		}
+
+		data := &contracts.ChangeAdminPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		if err := changeProxyAdmin(db, a, p, data); err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		resp, err := contracts.NewProxyResponse(p, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+		render.Status(r, http.StatusAccepted)
+		render.Render(w, r, resp)
+	})
+}
+
+// deployProxy deploys the implementation, the ProxyAdmin of transparent
+// proxies and the proxy with consecutive nonces, so both exist when the
+// proxy constructor runs. The proxy is registered as a contract with the
+// implementation ABI.
+func deployProxy(db *gorm.DB, a *accounts.Account, data *contracts.ProxyPayload) (*contracts.Proxy, error) {
+	conf, err := getConfig()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+
+	if data.Network == "" {
+		data.Network = defaultNetwork
+	}
+
+	ic, err := buildInitCode(db, conf, a, &data.DeployPayload)
+	if err != nil {
+		return nil, err
+	}
+	initData, err := initializerCalldata(ic, data.Initializer)
+	if err != nil {
+		return nil, err
+	}
+	if data.Kind == contracts.ProxyUUPS {
+		if err := contracts.CheckUUPSImplementation(ic.abi, false); err != nil {
+			return nil, helpers.ErrBadRequest(err)
+		}
+	}
+
+	s, err := newSender(conf, data.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	implDeployment, err := sendImplementation(db, a, s, ic)
+	if err != nil {
+		return nil, err
+	}
+	implAddress := common.HexToAddress(implDeployment.Address)
+
+	// The wallet owns the ProxyAdmin instead of being the admin itself, so
+	// its calls to the proxy are delegated like any other.
+	var admin common.Address
+	if data.Kind == contracts.ProxyTransparent {
+		adminDeployment, err := sendProxyAdmin(db, a, s)
+		if err != nil {
+			return nil, err
+		}
+		admin = common.HexToAddress(adminDeployment.Address)
+	}
+
+	proxyCode, err := contracts.ProxyInitCode(data.Kind, implAddress, admin, initData)
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	proxyAddress := s.nextContractAddress()
+	msg := s.msg(nil, proxyCode)
+	msg.Gas = proxyGasLimit
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &contracts.Contract{
+		Name:       ic.contract.Name,
+		SourceName: ic.contract.SourceName,
+		ABI:        ic.contract.ABI,
+		Network:    data.Network,
+		Address:    proxyAddress.Hex(),
+	}
+	proxyArgs := []interface{}{implAddress.Hex(), admin.Hex(), hexutil.Encode(initData)}
+	if data.Kind == contracts.ProxyUUPS {
+		proxyArgs = []interface{}{implAddress.Hex(), hexutil.Encode(initData)}
+	}
+	rawArgs, err := rawJSONArgs(proxyArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &contracts.Proxy{
+		Kind:                  data.Kind,
+		Network:               data.Network,
+		AccountID:             a.ID.String(),
+		Address:               proxyAddress.Hex(),
+		ImplementationID:      ic.contract.ID.String(),
+		ImplementationAddress: implAddress.Hex(),
+	}
+	if data.Kind == contracts.ProxyTransparent {
+		p.Admin = admin.Hex()
+	}
+	pi := &contracts.ProxyImplementation{
+		ContractID:      ic.contract.ID.String(),
+		DeploymentID:    implDeployment.ID.String(),
+		Address:         implAddress.Hex(),
+		TransactionHash: tx.Hash().Hex(),
+		Status:          contracts.TxPending,
+	}
+
+	d, t, err := newDeploymentRecords(a, s, &initCode{contract: pc, rawArgs: rawArgs}, proxyAddress, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The records reference each other's generated IDs.
+	if err := db.Transaction(func(dbTx *gorm.DB) error {
+		if err := dbTx.Create(pc).Error; err != nil {
+			return err
+		}
+		if err := dbTx.Create(&contracts.MyContract{AccountID: a.ID.String(), ContractID: pc.ID.String()}).Error; err != nil {
+			return err
+		}
+
+		t.ContractID = pc.ID.String()
+		d.ContractID = pc.ID.String()
+		if err := dbTx.Create(t).Error; err != nil {
+			return err
+		}
+		if err := dbTx.Create(d).Error; err != nil {
+			return err
+		}
+
+		p.ContractID = pc.ID.String()
+		p.DeploymentID = d.ID.String()
+		if err := dbTx.Create(p).Error; err != nil {
+			return err
+		}
+		pi.ProxyID = p.ID.String()
+		return dbTx.Create(pi).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if receipt := watchDeployment(db, s.client, d, t, tx, msg, ic.abiJSON); receipt != nil {
+			applyProxyReceipt(db, s.network, tx, receipt)
+		}
+	}()
+
+	return p, nil
+}
+
+// upgradeProxy deploys the implementation of an upgrade request and sends
+// the upgrade transaction right after it, to the ProxyAdmin of transparent
+// proxies. The proxy and its ABI are switched to the new implementation
+// once the upgrade is mined.
+func upgradeProxy(db *gorm.DB, a *accounts.Account, p *contracts.Proxy, data *contracts.UpgradePayload) error {
+	conf, err := getConfig()
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+
+	ic, err := buildInitCode(db, conf, a, &contracts.DeployPayload{
+		ContractID: data.ContractID,
+		Network:    p.Network,
+		Args:       data.Args,
+		Libraries:  data.Libraries,
+	})
+	if err != nil {
+		return err
+	}
+	initData, err := initializerCalldata(ic, data.Initializer)
+	if err != nil {
+		return err
+	}
+
+	report, err := p.CompareLayout(ic.contract, db)
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	if report != nil && !report.Compatible {
+		return helpers.ErrStorageLayoutIncompatible(report)
+	}
+
+	pc := &contracts.Contract{}
+	if err := db.Where("id = ?", p.ContractID).First(pc).Error; err != nil {
+		return helpers.ErrInternal(err)
+	}
+
+	if p.Kind == contracts.ProxyUUPS {
+		// The current implementation performs the upgrade and the new one
+		// has to be able to perform the next.
+		current, err := abi.JSON(strings.NewReader(string(pc.ABI.RawMessage)))
+		if err != nil {
+			return helpers.ErrABIInvalid(err)
+		}
+		if err := contracts.CheckUUPSImplementation(current, len(initData) > 0); err != nil {
+			return helpers.ErrBadRequest(fmt.Errorf("current implementation: %v", err))
+		}
+		if err := contracts.CheckUUPSImplementation(ic.abi, false); err != nil {
+			return helpers.ErrBadRequest(err)
+		}
+	}
+
+	s, err := newSender(conf, p.Network)
+	if err != nil {
+		return err
+	}
+	if p.Kind == contracts.ProxyTransparent {
+		if err := checkProxyAdmin(s, common.HexToAddress(p.Admin)); err != nil {
+			return err
+		}
+	}
+
+	implDeployment, err := sendImplementation(db, a, s, ic)
+	if err != nil {
+		return err
+	}
+	implAddress := common.HexToAddress(implDeployment.Address)
+
+	proxyAddress := common.HexToAddress(p.Address)
+	to := proxyAddress
+	var calldata []byte
+	if p.Kind == contracts.ProxyTransparent {
+		to = common.HexToAddress(p.Admin)
+		calldata, err = contracts.ProxyAdminUpgradeCalldata(proxyAddress, implAddress, initData)
+	} else {
+		calldata, err = contracts.UpgradeCalldata(implAddress, initData)
+	}
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	msg := s.msg(&to, calldata)
+	msg.Gas = proxyGasLimit
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return err
+	}
+
+	t := &contracts.Transaction{
+		Hash:       tx.Hash().Hex(),
+		AccountID:  a.ID.String(),
+		ContractID: p.ContractID,
+		Network:    s.network,
+		From:       s.from().Hex(),
+		To:         to.Hex(),
+		Nonce:      tx.Nonce(),
+		Status:     contracts.TxPending,
+	}
+	pi := &contracts.ProxyImplementation{
+		ProxyID:         p.ID.String(),
+		ContractID:      ic.contract.ID.String(),
+		DeploymentID:    implDeployment.ID.String(),
+		Address:         implAddress.Hex(),
+		TransactionHash: tx.Hash().Hex(),
+		Status:          contracts.TxPending,
+	}
+	if err := createRecords(db, t, pi); err != nil {
+		return err
+	}
+
+	go func() {
+		if receipt := watchTransaction(db, s.client, t, tx, msg, string(pc.ABI.RawMessage)); receipt != nil {
+			applyProxyReceipt(db, s.network, tx, receipt)
+		}
+	}()
+
+	return nil
+}
+
+// changeProxyAdmin sends the transaction handing a transparent proxy over
+// to the admin of a request through its ProxyAdmin. The proxy admin is
+// updated once it is mined.
+func changeProxyAdmin(db *gorm.DB, a *accounts.Account, p *contracts.Proxy, data *contracts.ChangeAdminPayload) error {
+	if p.Kind != contracts.ProxyTransparent {
+		return helpers.ErrBadRequest(fmt.Errorf("%v proxies have no admin", p.Kind))
+	}
+
+	conf, err := getConfig()
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	s, err := newSender(conf, p.Network)
+	if err != nil {
+		return err
+	}
+	admin := common.HexToAddress(p.Admin)
+	if err := checkProxyAdmin(s, admin); err != nil {
+		return err
+	}
+
+	calldata, err := contracts.ChangeProxyAdminCalldata(common.HexToAddress(p.Address), common.HexToAddress(data.Admin))
+	if err != nil {
+		return helpers.ErrInternal(err)
+	}
+	msg := s.msg(&admin, calldata)
+	reason, err := callForRevert(s, string(contracts.ProxyAdminArtifact().ABI), msg)
+	if err != nil {
+		return err
+	}
+	if reason != nil {
+		return helpers.ErrExecutionReverted(reason)
+	}
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return err
+	}
+
+	t := &contracts.Transaction{
+		Hash:       tx.Hash().Hex(),
+		AccountID:  a.ID.String(),
+		ContractID: p.ContractID,
+		Network:    s.network,
+		From:       s.from().Hex(),
+		To:         admin.Hex(),
+		Nonce:      tx.Nonce(),
+		Status:     contracts.TxPending,
+	}
+	if err := createRecords(db, t); err != nil {
+		return err
+	}
+
+	go func() {
+		if receipt := watchTransaction(db, s.client, t, tx, msg, ""); receipt != nil {
+			applyProxyReceipt(db, s.network, tx, receipt)
+		}
+	}()
+
+	return nil
+}
+
+// checkProxyAdmin returns a conflict unless admin is a ProxyAdmin owned by
+// the wallet.
+func checkProxyAdmin(s *sender, admin common.Address) error {
+	owner, err := contracts.ProxyAdminOwner(context.Background(), s.client, admin)
+	if errors.Is(err, contracts.ErrNotProxyAdmin) {
+		return helpers.ErrConflict(fmt.Errorf("proxy admin %v is not a ProxyAdmin, it was changed or is not mined yet", admin.Hex()))
+	}
+	if err != nil {
+		return err
+	}
+	if owner != s.from() {
+		return helpers.ErrConflict(fmt.Errorf("proxy admin %v is owned by %v, not the wallet %v", admin.Hex(), owner.Hex(), s.from().Hex()))
+	}
+	return nil
+}
+
+// sendImplementation deploys a contract a proxy depends on, its
+// implementation or ProxyAdmin, after simulating its constructor and
+// records the deployment.
+func sendImplementation(db *gorm.DB, a *accounts.Account, s *sender, ic *initCode) (*contracts.Deployment, error) {
+	address := s.nextContractAddress()
+	msg := s.msg(nil, ic.code)
+
+	reason, err := callForRevert(s, ic.abiJSON, msg)
+	if err != nil {
+		return nil, err
+	}
+	if reason != nil {
+		return nil, helpers.ErrExecutionReverted(reason)
+	}
+	if err := s.estimateGas(&msg); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.send(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	d, t, err := newDeploymentRecords(a, s, ic, address, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := createRecords(db, t, d); err != nil {
+		return nil, err
+	}
+
+	go watchDeployment(db, s.client, d, t, tx, msg, ic.abiJSON)
+
+	return d, nil
+}
+
+// sendProxyAdmin deploys a ProxyAdmin owned by the wallet and records the
+// deployment.
+func sendProxyAdmin(db *gorm.DB, a *accounts.Account, s *sender) (*contracts.Deployment, error) {
+	c, err := contracts.ProxyAdminContract(a.ID.String(), db)
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	return sendImplementation(db, a, s, &initCode{
+		contract: c,
+		abiJSON:  string(c.ABI.RawMessage),
+		bytecode: c.Bytecode,
+		rawArgs:  []json.RawMessage{},
+		code:     c.Bytecode,
+	})
+}
+
+// applyProxyReceipt applies a mined transaction to the proxies it concerns:
+// the implementation it pointed a proxy at and an admin change through a
+// ProxyAdmin. Both the watchers and the reconciliation call it, so it
+// ignores what was already applied.
+func applyProxyReceipt(db *gorm.DB, network string, tx *types.Transaction, receipt *types.Receipt) {
+	pi := &contracts.ProxyImplementation{}
+	if !db.Where("transaction_hash = ? AND status = ?", receipt.TxHash.Hex(), contracts.TxPending).First(pi).RecordNotFound() {
+		if updateProxyImplementation(db, pi, receipt) {
+			switchProxyImplementation(db, pi)
+		}
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful || tx.To() == nil {
+		return
+	}
+	proxy, admin, ok := contracts.DecodeChangeProxyAdmin(tx.Data())
+	if !ok {
+		return
+	}
+	if err := db.Model(&contracts.Proxy{}).Where("network = ? AND address = ? AND admin = ?", network, proxy.Hex(), tx.To().Hex()).Update("admin", admin.Hex()).Error; err != nil {
+		log.Printf("Watch: proxy %v: %v", proxy.Hex(), err)
+	}
+}
+
+// switchProxyImplementation points the proxy of pi at its implementation
+// and gives the proxy contract the implementation ABI, unless a later
+// implementation already succeeded.
+func switchProxyImplementation(db *gorm.DB, pi *contracts.ProxyImplementation) {
+	var later int
+	if err := db.Model(&contracts.ProxyImplementation{}).Where("proxy_id = ? AND status = ? AND created_at > ?", pi.ProxyID, contracts.TxSuccess, pi.CreatedAt).Count(&later).Error; err != nil {
+		log.Printf("Watch: proxy %v: %v", pi.ProxyID, err)
+		return
+	}
+	if later > 0 {
+		return
+	}
+
+	p := &contracts.Proxy{}
+	ic := &contracts.Contract{}
+	if err := db.Transaction(func(dbTx *gorm.DB) error {
+		if err := dbTx.Where("id = ?", pi.ProxyID).First(p).Error; err != nil {
+			return err
+		}
+		if err := dbTx.Where("id = ?", pi.ContractID).First(ic).Error; err != nil {
+			return err
+		}
+		if err := dbTx.Model(p).Updates(map[string]interface{}{
+			"implementation_id":      pi.ContractID,
+			"implementation_address": pi.Address,
+		}).Error; err != nil {
+			return err
+		}
+		return dbTx.Model(&contracts.Contract{}).Where("id = ?", p.ContractID).Update("abi", ic.ABI).Error
+	}); err != nil {
+		log.Printf("Watch: proxy %v: %v", pi.ProxyID, err)
+	}
+}
+
+// updateProxyImplementation stores the outcome of the transaction which
+// pointed a proxy at an implementation and reports whether it succeeded.
+func updateProxyImplementation(db *gorm.DB, pi *contracts.ProxyImplementation, receipt *types.Receipt) bool {
+	status := contracts.TxSuccess
+	if receipt.Status == types.ReceiptStatusFailed {
+		status = contracts.TxFailed
+	}
+	if err := db.Model(&contracts.ProxyImplementation{}).Where("id = ?", pi.ID).Update("status", status).Error; err != nil {
+		log.Printf("Watch: proxy implementation %v: %v", pi.ID, err)
+		return false
+	}
+	return status == contracts.TxSuccess
+}
+
+// initializerCalldata packs the initializer call of a request for the
+// implementation, or returns nil without one.
+func initializerCalldata(ic *initCode, call *contracts.CallPayload) ([]byte, error) {
+	if call == nil {
+		return nil, nil
+	}
+	data, err := contracts.PackCall(ic.abi, call.Method, call.Args)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(fmt.Errorf("initializer: %v", err))
+	}
+	return data, nil
+}
+
+// rawJSONArgs encodes values as a list of raw JSON arguments.
+func rawJSONArgs(values []interface{}) ([]json.RawMessage, error) {
+	raw := make([]json.RawMessage, len(values))
+	for i, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		raw[i] = b
+	}
+	return raw, nil
+}
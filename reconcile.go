@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/contracts"
+)
+
+// reconcileInterval is how often pending transactions are checked against
+// their receipts. Sent transactions are watched in the background, which
+// does not survive a restart and gives up after txWatchTimeout, so their
+// outcome is also picked up here.
+const reconcileInterval = time.Minute
+
+// reconcilePending reconciles pending transactions at startup and then
+// every reconcileInterval.
+func reconcilePending(db *gorm.DB) {
+	for {
+		reconcileTransactions(db, time.Now().Add(-reconcileInterval))
+		time.Sleep(reconcileInterval)
+	}
+}
+
+// reconcileTransactions stores the outcome of pending transactions,
+// deployments and proxy implementations created before since whose
+// transaction was mined. Those created later are still being watched.
+func reconcileTransactions(db *gorm.DB, since time.Time) {
+	var txs []*contracts.Transaction
+	if err := db.Where("status = ? AND created_at < ?", contracts.TxPending, since).Find(&txs).Error; err != nil {
+		log.Printf("Reconcile: %v", err)
+		return
+	}
+	var deployments []*contracts.Deployment
+	if err := db.Where("status = ? AND transaction_hash <> '' AND created_at < ?", contracts.TxPending, since).Find(&deployments).Error; err != nil {
+		log.Printf("Reconcile: %v", err)
+		return
+	}
+
+	// Transactions recorded before their network was stored were sent on
+	// the default network.
+	networks := map[string]string{}
+	for _, t := range txs {
+		networks[t.Hash] = t.Network
+		if t.Network == "" {
+			networks[t.Hash] = defaultNetwork
+		}
+	}
+	for _, d := range deployments {
+		networks[d.TransactionHash] = d.Network
+	}
+
+	// The implementation switch of a proxy follows its transaction, which
+	// may have been stored without it.
+	var implementations []*contracts.ProxyImplementation
+	if err := db.Where("status = ? AND created_at < ?", contracts.TxPending, since).Find(&implementations).Error; err != nil {
+		log.Printf("Reconcile: %v", err)
+		return
+	}
+	for _, pi := range implementations {
+		p := &contracts.Proxy{}
+		if !db.Where("id = ?", pi.ProxyID).First(p).RecordNotFound() {
+			networks[pi.TransactionHash] = p.Network
+		}
+	}
+
+	clients := map[string]*ethclient.Client{}
+	defer func() {
+		for _, client := range clients {
+			if client != nil {
+				client.Close()
+			}
+		}
+	}()
+	for hash, network := range networks {
+		client, ok := clients[network]
+		if !ok {
+			var err error
+			if client, err = configuredNetwork(network); err != nil {
+				log.Printf("Reconcile: %v: %v", network, err)
+			}
+			clients[network] = client
+		}
+		if client == nil {
+			continue
+		}
+		if err := reconcileTransaction(db, client, network, hash); err != nil {
+			log.Printf("Reconcile: transaction %v: %v", hash, err)
+		}
+	}
+}
+
+// reconcileTransaction stores the outcome of the transaction with hash on
+// network, of the deployments sent with it and of the proxies it concerns,
+// if it was mined.
+func reconcileTransaction(db *gorm.DB, client *ethclient.Client, network string, hash string) error {
+	ctx := context.Background()
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(hash))
+	if err == ethereum.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tx, _, err := client.TransactionByHash(ctx, receipt.TxHash)
+	if err != nil {
+		return err
+	}
+
+	t := &contracts.Transaction{}
+	if !db.Where("hash = ? AND status = ?", hash, contracts.TxPending).First(t).RecordNotFound() {
+		msg := ethereum.CallMsg{From: common.HexToAddress(t.From), To: tx.To(), Gas: tx.Gas(), GasPrice: tx.GasPrice(), Value: tx.Value(), Data: tx.Data()}
+
+		var abiJSON string
+		c := &contracts.Contract{}
+		if t.ContractID != "" && !db.Where("id = ?", t.ContractID).First(c).RecordNotFound() {
+			abiJSON = string(c.ABI.RawMessage)
+		}
+		storeReceipt(db, client, t, receipt, msg, abiJSON)
+	}
+	storeDeploymentReceipt(db, hash, receipt)
+	applyProxyReceipt(db, network, tx, receipt)
+	return nil
+}
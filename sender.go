@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// defaultGasLimit is the gas limit of transactions which are not estimated.
+const defaultGasLimit = uint64(300000)
+
+// withGasMargin adds a fifth to an estimated gas limit, for state which
+// changes between the estimate and the block the transaction is mined in.
+func withGasMargin(gas uint64) uint64 {
+	return gas + gas/5
+}
+
+// sender sends transactions from the Upvest wallet to one network. Nonces
+// are assigned locally so several transactions can be sent in a row
+// without waiting for the previous ones to be mined.
+type sender struct {
+	network string
+	client  *ethclient.Client
+	rpc     *rpc.Client
+	opts    *bind.TransactOpts
+}
+
+// newSender connects to network and fetches the wallet's pending nonce and
+// the suggested gas price.
+func newSender(conf *configuration, network string) (*sender, error) {
+	rpcClient, err := dialRPC(conf, network)
+	if err != nil {
+		return nil, err
+	}
+	client := ethclient.NewClient(rpcClient)
+
+	opts, err := newUpvestTransactor(newUpvestClient(conf))
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := client.PendingNonceAt(context.Background(), opts.From)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.Value = big.NewInt(0) // in wei
+	opts.GasLimit = defaultGasLimit
+	opts.GasPrice = gasPrice
+
+	return &sender{network: network, client: client, rpc: rpcClient, opts: opts}, nil
+}
+
+// from returns the wallet address.
+func (s *sender) from() common.Address {
+	return s.opts.From
+}
+
+// nextContractAddress returns the address of the contract created by the
+// next transaction if it is a deployment.
+func (s *sender) nextContractAddress() common.Address {
+	return crypto.CreateAddress(s.opts.From, s.opts.Nonce.Uint64())
+}
+
+// msg returns a message sending data to to, or deploying it when to is
+// nil, with the default gas limit.
+func (s *sender) msg(to *common.Address, data []byte) ethereum.CallMsg {
+	return ethereum.CallMsg{
+		From:     s.opts.From,
+		To:       to,
+		Gas:      s.opts.GasLimit,
+		GasPrice: s.opts.GasPrice,
+		Value:    s.opts.Value,
+		Data:     data,
+	}
+}
+
+// estimateGas sets the gas limit of msg to the estimate of the node plus
+// the margin of withGasMargin. The node searches up to the block gas
+// limit rather than the limit msg already has.
+func (s *sender) estimateGas(msg *ethereum.CallMsg) error {
+	estimate := *msg
+	estimate.Gas = 0
+	gas, err := s.client.EstimateGas(context.Background(), estimate)
+	if err != nil {
+		return err
+	}
+	msg.Gas = withGasMargin(gas)
+	return nil
+}
+
+// send signs and sends msg with the next nonce.
+func (s *sender) send(msg ethereum.CallMsg) (*types.Transaction, error) {
+	opts := *s.opts
+	opts.Nonce = new(big.Int).Set(s.opts.Nonce)
+	opts.GasLimit = msg.Gas
+
+	var tx *types.Transaction
+	var err error
+	if msg.To == nil {
+		// The constructor arguments are already part of the data.
+		_, tx, _, err = bind.DeployContract(&opts, abi.ABI{}, msg.Data, s.client)
+	} else {
+		tx, err = bind.NewBoundContract(*msg.To, abi.ABI{}, s.client, s.client, s.client).RawTransact(&opts, msg.Data)
+	}
+	if err != nil {
+		var sigErr *SignatureError
+		if errors.As(err, &sigErr) {
+			return nil, helpers.NewErrorResponse(http.StatusBadGateway, helpers.CodeInvalidSignature, err)
+		}
+		return nil, err
+	}
+
+	s.opts.Nonce.Add(s.opts.Nonce, big.NewInt(1))
+	return tx, nil
+}
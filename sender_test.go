@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeEstimator serves eth_estimateGas with a fixed estimate and records
+// the messages estimated.
+type fakeEstimator struct {
+	estimate uint64
+	calls    []map[string]interface{}
+}
+
+func (e *fakeEstimator) EstimateGas(call map[string]interface{}) hexutil.Uint64 {
+	e.calls = append(e.calls, call)
+	return hexutil.Uint64(e.estimate)
+}
+
+func TestSenderEstimateGas(t *testing.T) {
+	estimator := &fakeEstimator{estimate: 100000}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", estimator); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	s := &sender{client: ethclient.NewClient(rpc.DialInProc(server)), opts: &bind.TransactOpts{GasLimit: defaultGasLimit}}
+	msg := s.msg(nil, []byte{0x60, 0x00})
+	if err := s.estimateGas(&msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Gas != 120000 {
+		t.Errorf("gas = %d, want the estimate of 100000 plus a fifth", msg.Gas)
+	}
+	// The node estimates up to the block gas limit, not the default limit.
+	if len(estimator.calls) != 1 || estimator.calls[0]["gas"] != nil {
+		t.Errorf("estimated %v, want one message without gas", estimator.calls)
+	}
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	msg = ethereum.CallMsg{To: &to, Gas: 50000}
+	if err := s.estimateGas(&msg); err != nil || msg.Gas != 120000 {
+		t.Errorf("estimateGas() = %d, %v, want 120000", msg.Gas, err)
+	}
+}
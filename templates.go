@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// templateDeployHandler registers a template as a contract linked to the
+// current account and deploys it with the validated parameters.
+func templateDeployHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		t, ok := contracts.FindTemplate(name)
+		if !ok {
+			render.Render(w, r, helpers.ErrNotFound("template", name))
+			return
+		}
+
+		data := &contracts.TemplateDeployPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		conf, err := getConfig()
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		args, err := t.ConstructorArgs(data.Parameters, func() (common.Address, error) {
+			opts, err := newUpvestTransactor(newUpvestClient(conf))
+			if err != nil {
+				return common.Address{}, err
+			}
+			return opts.From, nil
+		})
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		c, err := contracts.TemplateContract(a.ID.String(), t, db)
+		if err != nil {
+			render.Render(w, r, helpers.ErrInternal(err))
+			return
+		}
+
+		d, created, err := deployContract(db, a, &contracts.DeployPayload{
+			ContractID: c.ID.String(),
+			Network:    data.Network,
+			Args:       args,
+			Salt:       data.Salt,
+		})
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+
+		if created {
+			render.Status(r, http.StatusCreated)
+		}
+		render.Render(w, r, &contracts.DeploymentResponse{Deployment: d})
+	})
+}
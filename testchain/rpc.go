@@ -0,0 +1,303 @@
+package testchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// errorSelector is the selector of Error(string) reverts.
+var errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// RPC returns a client of the chain's JSON-RPC API. The API has the eth
+// methods contract reads and senders use, eth_call at any block and
+// newHeads subscriptions. Reverted calls fail with code 3 and the message
+// "execution reverted", followed by the reason of Error(string) reverts.
+// Raw transactions are mined at once, each in a block of its own.
+func (c *Chain) RPC() *rpc.Client {
+	client := rpc.DialInProc(c.rpcServer())
+	c.t.Cleanup(client.Close)
+	return client
+}
+
+// URL serves the API of RPC over HTTP and returns its URL.
+func (c *Chain) URL() string {
+	server := httptest.NewServer(c.rpcServer())
+	c.t.Cleanup(server.Close)
+	return server.URL
+}
+
+func (c *Chain) rpcServer() *rpc.Server {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.server == nil {
+		c.server = rpc.NewServer()
+		if err := c.server.RegisterName("eth", &ethService{c: c}); err != nil {
+			c.t.Fatal(err)
+		}
+		c.t.Cleanup(c.server.Stop)
+	}
+	return c.server
+}
+
+// Dial connects to the chain like RPC, whatever the network.
+func (c *Chain) Dial(network string) (*ethclient.Client, error) {
+	return ethclient.NewClient(c.RPC()), nil
+}
+
+type ethService struct {
+	c *Chain
+}
+
+// callArgs is the call object of eth_call.
+type callArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+// revertError is the error of a reverted call.
+type revertError struct {
+	reason string
+}
+
+func (e *revertError) ErrorCode() int { return 3 }
+
+func (e *revertError) Error() string {
+	if e.reason == "" {
+		return "execution reverted"
+	}
+	return "execution reverted: " + e.reason
+}
+
+func (s *ethService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(s.c.Blockchain().Config().ChainID)
+}
+
+func (s *ethService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.c.Blockchain().CurrentBlock().NumberU64())
+}
+
+func (s *ethService) GetBlockByNumber(number rpc.BlockNumber, full bool) (*types.Header, error) {
+	block := s.block(number)
+	if block == nil {
+		return nil, nil
+	}
+	return block.Header(), nil
+}
+
+func (s *ethService) GetCode(address common.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	st, _, err := s.state(number)
+	if err != nil {
+		return nil, err
+	}
+	return st.GetCode(address), nil
+}
+
+func (s *ethService) Call(args callArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	st, block, err := s.state(number)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := block.GasLimit()
+	if args.Gas != nil {
+		gas = uint64(*args.Gas)
+	}
+	gasPrice, value := new(big.Int), new(big.Int)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+	// The caller pays for nothing but the value, like in eth_call.
+	st.AddBalance(args.From, value)
+
+	msg := types.NewMessage(args.From, args.To, 0, value, gas, gasPrice, args.Data, false)
+	evm := vm.NewEVM(core.NewEVMContext(msg, block.Header(), s.c.Blockchain(), nil), st, s.c.Blockchain().Config(), vm.Config{})
+	out, _, failed, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(block.GasLimit()))
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return nil, &revertError{reason: errorReason(out)}
+	}
+	return out, nil
+}
+
+func (s *ethService) GetTransactionCount(address common.Address, number rpc.BlockNumber) (hexutil.Uint64, error) {
+	st, _, err := s.state(number)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(st.GetNonce(address)), nil
+}
+
+func (s *ethService) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := s.c.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (s *ethService) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	msg := ethereum.CallMsg{From: args.From, To: args.To, Data: args.Data}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	gas, err := s.c.EstimateGas(ctx, msg)
+	return hexutil.Uint64(gas), err
+}
+
+// SendRawTransaction mines the transaction in a new block. Invalid
+// transactions fail instead of panicking the simulated backend.
+func (s *ethService) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(data, tx); err != nil {
+		return common.Hash{}, err
+	}
+	from, err := types.Sender(s.signer(), tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	nonce, err := s.c.PendingNonceAt(ctx, from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Nonce() != nonce {
+		return common.Hash{}, fmt.Errorf("invalid nonce %d, want %d", tx.Nonce(), nonce)
+	}
+	if err := s.c.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	s.c.Commit()
+	return tx.Hash(), nil
+}
+
+func (s *ethService) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return s.c.TransactionReceipt(ctx, hash)
+}
+
+// GetTransactionByHash returns the transaction with its sender and, once
+// mined, its block.
+func (s *ethService) GetTransactionByHash(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	tx, pending, err := s.c.TransactionByHash(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := tx.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields["from"], err = types.Sender(s.signer(), tx); err != nil {
+		return nil, err
+	}
+	if !pending {
+		receipt, err := s.c.TransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		fields["blockHash"], fields["blockNumber"] = receipt.BlockHash, (*hexutil.Big)(receipt.BlockNumber)
+	}
+	return fields, nil
+}
+
+func (s *ethService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	headers := make(chan *types.Header)
+	heads, err := s.c.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		defer heads.Unsubscribe()
+		for {
+			select {
+			case h := <-headers:
+				notifier.Notify(sub.ID, h)
+			case <-sub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// signer returns the signer of the chain's transactions.
+func (s *ethService) signer() types.Signer {
+	return types.NewEIP155Signer(s.c.Blockchain().Config().ChainID)
+}
+
+// block returns the block number, nil if the chain does not have it.
+func (s *ethService) block(number rpc.BlockNumber) *types.Block {
+	if number < 0 {
+		return s.c.Blockchain().CurrentBlock()
+	}
+	return s.c.Blockchain().GetBlockByNumber(uint64(number))
+}
+
+// state returns the state after the block number.
+func (s *ethService) state(number rpc.BlockNumber) (*state.StateDB, *types.Block, error) {
+	block := s.block(number)
+	if block == nil {
+		return nil, nil, errors.New("header not found")
+	}
+	st, err := s.c.Blockchain().StateAt(block.Root())
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, block, nil
+}
+
+// errorReason returns the message of an Error(string) revert, or "".
+func errorReason(out []byte) string {
+	if !bytes.HasPrefix(out, errorSelector) {
+		return ""
+	}
+	t, _ := abi.NewType("string", "", nil)
+	values, err := abi.Arguments{{Type: t}}.UnpackValues(out[4:])
+	if err != nil || len(values) == 0 {
+		return ""
+	}
+	reason, _ := values[0].(string)
+	return reason
+}
@@ -0,0 +1,116 @@
+// Package testchain runs simulated chains for tests. Its helpers fail the
+// test instead of returning errors.
+package testchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Balance is the balance of each account of a chain.
+var Balance = new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))
+
+// Chain is a simulated chain with funded accounts and their keys.
+type Chain struct {
+	*backends.SimulatedBackend
+	Accounts []*bind.TransactOpts
+	Keys     []*ecdsa.PrivateKey
+	t        testing.TB
+
+	mu     sync.Mutex
+	server *rpc.Server
+}
+
+// New returns a chain with the block gas limit gasLimit, the given number
+// of accounts holding Balance and the accounts in alloc. It is closed when
+// the test ends.
+func New(t testing.TB, gasLimit uint64, accounts int, alloc core.GenesisAlloc) *Chain {
+	c := &Chain{t: t}
+	genesis := core.GenesisAlloc{}
+	for address, account := range alloc {
+		genesis[address] = account
+	}
+	for i := 0; i < accounts; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := bind.NewKeyedTransactor(key)
+		c.Accounts = append(c.Accounts, opts)
+		c.Keys = append(c.Keys, key)
+		genesis[opts.From] = core.GenesisAccount{Balance: Balance}
+	}
+	c.SimulatedBackend = backends.NewSimulatedBackend(genesis, gasLimit)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// Address returns the address of account i.
+func (c *Chain) Address(i int) common.Address {
+	return c.Accounts[i].From
+}
+
+// Deploy deploys code from account from with a fixed gas limit, so failing
+// deployments are mined, and returns the address and the receipt.
+func (c *Chain) Deploy(from int, gas uint64, code []byte) (common.Address, *types.Receipt) {
+	opts := *c.Accounts[from]
+	opts.GasLimit = gas
+	address, tx, _, err := bind.DeployContract(&opts, abi.ABI{}, code, c)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return address, c.Mined(tx)
+}
+
+// Send sends data and value from account from to to with a fixed gas
+// limit, so failing transactions are mined, and returns the receipt.
+func (c *Chain) Send(from int, gas uint64, to common.Address, value *big.Int, data []byte) *types.Receipt {
+	opts := *c.Accounts[from]
+	opts.GasLimit = gas
+	opts.Value = value
+	tx, err := bind.NewBoundContract(to, abi.ABI{}, c, c, c).RawTransact(&opts, data)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return c.Mined(tx)
+}
+
+// Mined mines a block with tx and returns its receipt.
+func (c *Chain) Mined(tx *types.Transaction) *types.Receipt {
+	c.Commit()
+	receipt, err := c.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return receipt
+}
+
+// Balance returns the balance of address at the latest block.
+func (c *Chain) Balance(address common.Address) *big.Int {
+	b, err := c.BalanceAt(context.Background(), address, nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return b
+}
+
+// Skip mines a block d later than the previous one.
+func (c *Chain) Skip(d time.Duration) {
+	if err := c.AdjustTime(d); err != nil {
+		c.t.Fatal(err)
+	}
+	c.Commit()
+}
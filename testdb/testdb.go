@@ -0,0 +1,54 @@
+// Package testdb runs handlers against a mocked Postgres database for
+// tests. Its helpers fail the test instead of returning errors.
+package testdb
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	// Register the postgres dialect, so queries are built like in production.
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// New returns a database answering the queries expected from the mock.
+// The test fails when it ends if an expected query was not made within a
+// few seconds, so queries made in the background are waited for.
+func New(t testing.TB) (*gorm.DB, sqlmock.Sqlmock) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open("postgres", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.LogMode(false)
+	t.Cleanup(func() {
+		deadline := time.Now().Add(5 * time.Second)
+		for mock.ExpectationsWereMet() != nil && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		db.Close()
+	})
+	return db, mock
+}
+
+// Args returns n arguments of a query matching anything but the values,
+// keyed by their 1-based position.
+func Args(n int, values map[int]interface{}) []driver.Value {
+	args := make([]driver.Value, n)
+	for i := range args {
+		if v, ok := values[i+1]; ok {
+			args[i] = v
+		} else {
+			args[i] = sqlmock.AnyArg()
+		}
+	}
+	return args
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/jinzhu/gorm"
+	"github.com/mislavio/contracter/accounts"
+	"github.com/mislavio/contracter/auth"
+	"github.com/mislavio/contracter/contracts"
+	"github.com/mislavio/contracter/helpers"
+)
+
+// tokenTransferHandler transfers tokens from the wallet.
+func tokenTransferHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.TokenTransferPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		res, err := sendTokenCall(db, a, chi.URLParam(r, "id"), data.Network, "transfer", data.Amount, func(amount interface{}) []interface{} {
+			return []interface{}{common.HexToAddress(data.To), amount}
+		})
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, res)
+	})
+}
+
+// tokenTransferFromHandler transfers tokens on behalf of an owner which
+// approved the wallet.
+func tokenTransferFromHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.TokenTransferPayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+		if data.From == "" {
+			render.Render(w, r, helpers.ErrBadRequest(errors.New("missing from address")))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		res, err := sendTokenCall(db, a, chi.URLParam(r, "id"), data.Network, "transferFrom", data.Amount, func(amount interface{}) []interface{} {
+			return []interface{}{common.HexToAddress(data.From), common.HexToAddress(data.To), amount}
+		})
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, res)
+	})
+}
+
+// tokenApproveHandler sets the allowance of a spender over the tokens of
+// the wallet.
+func tokenApproveHandler(db *gorm.DB) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := &contracts.TokenApprovePayload{}
+		if err := render.Bind(r, data); err != nil {
+			render.Render(w, r, helpers.ErrBadRequest(err))
+			return
+		}
+
+		a, _ := auth.AccountFromContext(r.Context())
+
+		res, err := sendTokenCall(db, a, chi.URLParam(r, "id"), data.Network, "approve", data.Amount, func(amount interface{}) []interface{} {
+			return []interface{}{common.HexToAddress(data.Spender), amount}
+		})
+		if err != nil {
+			render.Render(w, r, helpers.ErrUpstream(err))
+			return
+		}
+		render.Render(w, r, res)
+	})
+}
+
+// sendTokenCall converts a decimal amount to base units of the token and
+// sends a call of method from the wallet with the arguments args builds
+// around it.
+func sendTokenCall(db *gorm.DB, a *accounts.Account, id string, network string, method string, amount string, args func(amount interface{}) []interface{}) (*contracts.TokenTransactionResponse, error) {
+	t, err := contracts.LoadToken(a.ID.String(), id, network, db)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := getConfig()
+	if err != nil {
+		return nil, helpers.ErrInternal(err)
+	}
+	s, err := newSender(conf, t.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := t.Decimals(context.Background(), callCache.Client(s.rpc, t.Network))
+	if err != nil {
+		return nil, err
+	}
+	if amount == contracts.AmountMax && method != "approve" {
+		return nil, helpers.ErrBadRequest(errors.New("max is only accepted for approvals"))
+	}
+	value, err := contracts.ParseUnits(amount, decimals)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+
+	data, err := t.ABI.Pack(method, args(value)...)
+	if err != nil {
+		return nil, helpers.ErrBadRequest(err)
+	}
+	tx, err := sendContractCall(db, a, s, t.Contract, t.Address, data)
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.TokenTransactionResponse{
+		Transaction: tx,
+		Amount:      contracts.FormatUnits(value, decimals),
+		AmountRaw:   value.String(),
+	}, nil
+}
@@ -3,24 +3,42 @@ package main
 import (
 	"encoding/base64"
 	"errors"
-	"log"
+	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/upvestco/upvest-go"
 )
 
-func newUpvestTransactor(c *upvest.ClienteleAPI) *bind.TransactOpts {
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// SignatureError is returned when an Upvest signature can not be turned
+// into a valid transaction signature for the wallet address.
+type SignatureError struct {
+	Address common.Address
+	Reason  string
+}
+
+func (e *SignatureError) Error() string {
+	return fmt.Sprintf("transactor: invalid signature for %v: %v", e.Address.Hex(), e.Reason)
+}
+
+func newUpvestTransactor(c *upvest.ClienteleAPI) (*bind.TransactOpts, error) {
 	conf, err := getConfig()
 	if err != nil {
-		log.Panic(err)
+		return nil, err
 	}
 
 	w, err := c.Wallet.Get(conf.UpvestWalletID)
 	if err != nil {
-		log.Panic(err)
+		return nil, err
 	}
 
 	fromAddress := common.HexToAddress(w.Address)
@@ -58,12 +76,74 @@ func newUpvestTransactor(c *upvest.ClienteleAPI) *bind.TransactOpts {
 				return nil, err
 			}
 
-			v := byte(recover)
+			signature, err := normaliseSignature(r, s, recover)
+			if err != nil {
+				return nil, &SignatureError{Address: fromAddress, Reason: err.Error()}
+			}
+
+			signedTx, err := tx.WithSignature(signer, signature)
+			if err != nil {
+				return nil, &SignatureError{Address: fromAddress, Reason: err.Error()}
+			}
 
-			signature := append(r, s...)
-			signature = append(signature, v)
+			// Recover the sender before broadcasting so a bad signature never reaches the node.
+			sender, err := types.Sender(signer, signedTx)
+			if err != nil {
+				return nil, &SignatureError{Address: fromAddress, Reason: err.Error()}
+			}
+			if sender != fromAddress {
+				return nil, &SignatureError{Address: fromAddress, Reason: "recovered address " + sender.Hex()}
+			}
 
-			return tx.WithSignature(signer, signature)
+			return signedTx, nil
 		},
+	}, nil
+}
+
+// normaliseSignature builds a 65 byte [R || S || V] signature with R and S
+// left-padded to 32 bytes, S in the lower half of the curve order and V as
+// a 0/1 recovery id.
+func normaliseSignature(r []byte, s []byte, recover int) ([]byte, error) {
+	if len(r) == 0 || len(r) > 32 {
+		return nil, fmt.Errorf("unexpected R length %d", len(r))
+	}
+	if len(s) == 0 || len(s) > 32 {
+		return nil, fmt.Errorf("unexpected S length %d", len(s))
+	}
+
+	v, err := recoveryID(recover)
+	if err != nil {
+		return nil, err
+	}
+
+	sInt := new(big.Int).SetBytes(s)
+	if sInt.Sign() == 0 || sInt.Cmp(secp256k1N) >= 0 {
+		return nil, errors.New("S out of range")
+	}
+	// Flip to low-S as required by EIP-2, which also flips the recovery id.
+	if sInt.Cmp(secp256k1HalfN) > 0 {
+		sInt.Sub(secp256k1N, sInt)
+		v ^= 1
+	}
+
+	signature := make([]byte, 65)
+	copy(signature[32-len(r):32], r)
+	copy(signature[64-len(sInt.Bytes()):64], sInt.Bytes())
+	signature[64] = v
+
+	return signature, nil
+}
+
+// recoveryID accepts V as a raw recovery id (0/1), a legacy value (27/28)
+// or an EIP-155 value (chainID*2 + 35/36).
+func recoveryID(v int) (byte, error) {
+	switch {
+	case v == 0 || v == 1:
+		return byte(v), nil
+	case v == 27 || v == 28:
+		return byte(v - 27), nil
+	case v >= 35:
+		return byte((v - 35) % 2), nil
 	}
+	return 0, fmt.Errorf("unexpected V value %d", v)
 }
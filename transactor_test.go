@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestRecoveryID(t *testing.T) {
+	tests := []struct {
+		v       int
+		want    byte
+		wantErr bool
+	}{
+		{v: 0, want: 0},
+		{v: 1, want: 1},
+		{v: 27, want: 0},
+		{v: 28, want: 1},
+		{v: 3*2 + 35, want: 0},
+		{v: 3*2 + 36, want: 1},
+		{v: 1337*2 + 36, want: 1},
+		{v: 2, wantErr: true},
+		{v: 29, wantErr: true},
+		{v: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := recoveryID(tt.v)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("recoveryID(%d) error = %v, wantErr %v", tt.v, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("recoveryID(%d) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestNormaliseSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := crypto.Keccak256([]byte("contracter"))
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, s, v := sig[:32], sig[32:64], int(sig[64])
+	highS := new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(s)).Bytes()
+
+	tests := []struct {
+		name    string
+		r       []byte
+		s       []byte
+		v       int
+		wantErr bool
+	}{
+		{name: "raw", r: r, s: s, v: v},
+		{name: "legacy v", r: r, s: s, v: v + 27},
+		{name: "eip-155 v", r: r, s: s, v: v + 3*2 + 35},
+		{name: "high s", r: r, s: highS, v: v ^ 1},
+		{name: "unpadded r", r: bytes.TrimLeft(r, "\x00"), s: s, v: v},
+		{name: "empty r", r: nil, s: s, v: v, wantErr: true},
+		{name: "long s", r: r, s: append([]byte{1}, s...), v: v, wantErr: true},
+		{name: "zero s", r: r, s: []byte{0}, v: v, wantErr: true},
+		{name: "s above n", r: r, s: secp256k1N.Bytes(), v: v, wantErr: true},
+		{name: "bad v", r: r, s: s, v: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normaliseSignature(tt.r, tt.s, tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(got, sig) {
+				t.Errorf("signature = %x, want %x", got, sig)
+			}
+			pub, err := crypto.SigToPub(hash, got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crypto.PubkeyToAddress(*pub) != crypto.PubkeyToAddress(key.PublicKey) {
+				t.Error("signature recovers another address")
+			}
+		})
+	}
+}